@@ -0,0 +1,545 @@
+package formats
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"proyecto/simplex/i18n"
+	"proyecto/simplex/models"
+)
+
+// ParseAlgebraic convierte un modelo escrito en notación algebraica en un SimplexRequest.
+//
+// Ejemplo del lenguaje:
+//
+//	max 3x + 5y
+//	subject to
+//	  c1: x <= 4
+//	  c2: 2y <= 12
+//	  3x + 2y <= 18
+//	bounds
+//	  y <= 8
+//	int x
+//
+// La primera línea es la función objetivo (max/min). Las restricciones admiten
+// nombre opcional ("nombre:") y los operadores <=, >= y =. La sección bounds
+// acepta "x <= u", "x >= l", "l <= x <= u" y "x free". "int" declara variables
+// enteras. Cada sentencia termina en un salto de línea o ';'.
+func ParseAlgebraic(src string) (models.SimplexRequest, error) {
+	tokens, err := tokenize(src)
+	if err != nil {
+		return models.SimplexRequest{}, err
+	}
+
+	p := &algebraicParser{
+		tokens:   tokens,
+		varIndex: make(map[string]int),
+		bounds:   make(map[int]*models.Bound),
+		integer:  make(map[int]bool),
+	}
+	if err := p.parseModel(); err != nil {
+		return models.SimplexRequest{}, err
+	}
+	return p.buildRequest(), nil
+}
+
+// linearExpr es una expresión lineal: coeficientes por variable y un término constante
+type linearExpr struct {
+	coefs    map[int]float64
+	constant float64
+}
+
+type parsedConstraint struct {
+	name string
+	expr linearExpr
+	kind string
+	rhs  float64
+}
+
+type algebraicParser struct {
+	tokens []token
+	pos    int
+
+	objType     string
+	objective   linearExpr
+	constraints []parsedConstraint
+
+	varNames []string
+	varIndex map[string]int
+	bounds   map[int]*models.Bound
+	integer  map[int]bool
+
+	lp bool // formato CPLEX LP: los saltos de línea no separan sentencias
+}
+
+// Secciones del modelo
+const (
+	sectionConstraints = iota
+	sectionBounds
+)
+
+func (p *algebraicParser) peek() token { return p.tokens[p.pos] }
+
+func (p *algebraicParser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *algebraicParser) errorAt(t token, code i18n.Code, args ...any) error {
+	return newSyntaxError(t.line, t.column, code, args...)
+}
+
+// isKeyword indica si el token es la palabra clave dada (sin distinguir mayúsculas)
+func isKeyword(t token, words ...string) bool {
+	if t.kind != tokIdent {
+		return false
+	}
+	for _, w := range words {
+		if strings.EqualFold(t.text, w) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *algebraicParser) skipNewlines() {
+	for p.peek().kind == tokNewline {
+		p.next()
+	}
+}
+
+// endStatement exige un fin de línea (o el fin del modelo) después de una sentencia
+func (p *algebraicParser) endStatement() error {
+	t := p.peek()
+	if t.kind != tokNewline && t.kind != tokEOF {
+		return p.errorAt(t, i18n.SyntaxExpectedEndOfLine, t.describe())
+	}
+	p.skipNewlines()
+	return nil
+}
+
+func (p *algebraicParser) parseModel() error {
+	p.skipNewlines()
+	if err := p.parseObjective(); err != nil {
+		return err
+	}
+
+	section := sectionConstraints
+	for p.peek().kind != tokEOF {
+		t := p.peek()
+		switch {
+		case isKeyword(t, "subject"):
+			p.next()
+			if !isKeyword(p.peek(), "to") {
+				return p.errorAt(p.peek(), i18n.SyntaxExpectedAfter, "to", "subject")
+			}
+			p.next()
+			section = sectionConstraints
+			p.skipNewlines()
+			continue
+		case isKeyword(t, "st", "such"):
+			p.next()
+			if strings.EqualFold(t.text, "such") {
+				if !isKeyword(p.peek(), "that") {
+					return p.errorAt(p.peek(), i18n.SyntaxExpectedAfter, "that", "such")
+				}
+				p.next()
+			}
+			section = sectionConstraints
+			p.skipNewlines()
+			continue
+		case isKeyword(t, "bounds", "bound"):
+			p.next()
+			section = sectionBounds
+			p.skipNewlines()
+			continue
+		case isKeyword(t, "int", "integer", "integers", "general", "generals"):
+			p.next()
+			if err := p.parseIntegers(); err != nil {
+				return err
+			}
+		case isKeyword(t, "end"):
+			p.next()
+			p.skipNewlines()
+			if t := p.peek(); t.kind != tokEOF {
+				return p.errorAt(t, i18n.SyntaxTrailingContent, "end")
+			}
+			continue
+		case section == sectionBounds:
+			if err := p.parseBound(); err != nil {
+				return err
+			}
+		default:
+			if err := p.parseConstraint(); err != nil {
+				return err
+			}
+		}
+		if err := p.endStatement(); err != nil {
+			return err
+		}
+	}
+
+	if len(p.constraints) == 0 {
+		return p.errorAt(p.peek(), i18n.SyntaxNoConstraints)
+	}
+	return nil
+}
+
+// parseObjective lee "max|min [nombre:|nombre =] expresión"
+func (p *algebraicParser) parseObjective() error {
+	t := p.next()
+	switch {
+	case isKeyword(t, "max", "maximize", "maximise", "maximum"):
+		p.objType = "max"
+	case isKeyword(t, "min", "minimize", "minimise", "minimum"):
+		p.objType = "min"
+	default:
+		return p.errorAt(t, i18n.SyntaxModelStart, "max", "min", t.describe())
+	}
+
+	// Nombre opcional de la función objetivo: "max z = ..." o "max z: ..."
+	if p.peek().kind == tokIdent {
+		if k := p.tokens[p.pos+1].kind; k == tokColon || k == tokEQ {
+			p.pos += 2
+		}
+	}
+
+	start := p.peek()
+	expr, err := p.parseExpr()
+	if err != nil {
+		return err
+	}
+	if len(expr.coefs) == 0 {
+		return p.errorAt(start, i18n.SyntaxEmptyObjective)
+	}
+	if expr.constant != 0 {
+		return p.errorAt(start, i18n.SyntaxObjectiveConstant)
+	}
+	p.objective = expr
+	return p.endStatement()
+}
+
+// parseConstraint lee "[nombre:] expresión relación expresión"
+func (p *algebraicParser) parseConstraint() error {
+	name := ""
+	if p.peek().kind == tokIdent && p.tokens[p.pos+1].kind == tokColon {
+		name = p.next().text
+		p.next()
+	}
+
+	start := p.peek()
+	left, err := p.parseExpr()
+	if err != nil {
+		return err
+	}
+	rel := p.next()
+	kind, ok := relationType(rel)
+	if !ok {
+		return p.errorAt(rel, i18n.SyntaxExpectedRelation, rel.describe())
+	}
+	right, err := p.parseExpr()
+	if err != nil {
+		return err
+	}
+
+	// Pasar las variables a la izquierda y las constantes a la derecha
+	for j, c := range right.coefs {
+		left.coefs[j] -= c
+	}
+	for j, c := range left.coefs {
+		if c == 0 {
+			delete(left.coefs, j)
+		}
+	}
+	if len(left.coefs) == 0 {
+		return p.errorAt(start, i18n.SyntaxEmptyConstraint)
+	}
+	return p.addConstraint(start, name, left.coefs, kind, right.constant-left.constant)
+}
+
+// addConstraint registra una restricción ya normalizada (variables a la izquierda)
+func (p *algebraicParser) addConstraint(start token, name string, coefs map[int]float64, kind string, rhs float64) error {
+	if name == "" {
+		name = fmt.Sprintf("c%d", len(p.constraints)+1)
+	}
+	for _, c := range p.constraints {
+		if c.name == name {
+			return p.errorAt(start, i18n.SyntaxDuplicateConstraint, name)
+		}
+	}
+
+	p.constraints = append(p.constraints, parsedConstraint{
+		name: name,
+		expr: linearExpr{coefs: coefs},
+		kind: kind,
+		rhs:  rhs,
+	})
+	return nil
+}
+
+// parseBound lee "x <= u", "x >= l", "x = v", "l <= x <= u" o "x free"
+func (p *algebraicParser) parseBound() error {
+	start := p.peek()
+
+	// Forma "l <= x <= u"
+	if start.kind == tokNumber || start.kind == tokMinus || start.kind == tokPlus {
+		lower, err := p.parseBoundValue()
+		if err != nil {
+			return err
+		}
+		rel := p.next()
+		if rel.kind != tokLE {
+			return p.errorAt(rel, i18n.SyntaxExpectedLE, rel.describe())
+		}
+		j, err := p.boundVariable()
+		if err != nil {
+			return err
+		}
+		b := p.boundFor(j)
+		setLower(b, lower)
+		if p.peek().kind == tokLE {
+			p.next()
+			upper, err := p.parseBoundValue()
+			if err != nil {
+				return err
+			}
+			if !math.IsInf(upper, 1) {
+				b.Upper = &upper
+			}
+		}
+		return nil
+	}
+
+	j, err := p.boundVariable()
+	if err != nil {
+		return err
+	}
+	b := p.boundFor(j)
+
+	if isKeyword(p.peek(), "free") {
+		p.next()
+		b.Free = true
+		b.Lower = nil
+		return nil
+	}
+
+	rel := p.next()
+	kind, ok := relationType(rel)
+	if !ok {
+		return p.errorAt(rel, i18n.SyntaxExpectedBound, rel.describe())
+	}
+	value, err := p.parseBoundValue()
+	if err != nil {
+		return err
+	}
+	switch kind {
+	case "le":
+		if !math.IsInf(value, 1) {
+			b.Upper = &value
+		}
+	case "ge":
+		setLower(b, value)
+	case "eq":
+		setLower(b, value)
+		b.Upper = &value
+	}
+	return nil
+}
+
+// setLower asigna la cota inferior; -infinito marca la variable como libre
+func setLower(b *models.Bound, value float64) {
+	if math.IsInf(value, -1) {
+		b.Free = true
+		b.Lower = nil
+		return
+	}
+	b.Free = false
+	b.Lower = &value
+}
+
+// parseBoundValue lee un número con signo opcional; admite "inf" e "infinity"
+func (p *algebraicParser) parseBoundValue() (float64, error) {
+	sign := 1.0
+	if t := p.peek(); t.kind == tokMinus || t.kind == tokPlus {
+		p.next()
+		if t.kind == tokMinus {
+			sign = -1
+		}
+	}
+	t := p.next()
+	switch {
+	case t.kind == tokNumber:
+		return sign * t.value, nil
+	case isKeyword(t, "inf", "infinity"):
+		return math.Inf(int(sign)), nil
+	}
+	return 0, p.errorAt(t, i18n.SyntaxExpectedNumber, t.describe())
+}
+
+// boundVariable lee el nombre de una variable ya usada en el modelo
+func (p *algebraicParser) boundVariable() (int, error) {
+	t := p.next()
+	if t.kind != tokIdent {
+		return 0, p.errorAt(t, i18n.SyntaxExpectedVariable, t.describe())
+	}
+	j, ok := p.varIndex[t.text]
+	if !ok {
+		return 0, p.errorAt(t, i18n.SyntaxUnknownVariable, t.text)
+	}
+	return j, nil
+}
+
+func (p *algebraicParser) boundFor(j int) *models.Bound {
+	if b, ok := p.bounds[j]; ok {
+		return b
+	}
+	b := &models.Bound{}
+	p.bounds[j] = b
+	return b
+}
+
+// parseIntegers lee la lista de variables después de "int": "int x, y" o "int x y"
+func (p *algebraicParser) parseIntegers() error {
+	count := 0
+	for {
+		t := p.peek()
+		if t.kind == tokComma && count > 0 {
+			p.next()
+			continue
+		}
+		if t.kind != tokIdent || p.isReserved(t) {
+			break
+		}
+		j, err := p.boundVariable()
+		if err != nil {
+			return err
+		}
+		p.integer[j] = true
+		count++
+	}
+	if count == 0 {
+		return p.errorAt(p.peek(), i18n.SyntaxIntegerEmpty)
+	}
+	return nil
+}
+
+// parseExpr lee una suma de términos: [signo] [número] [*] [variable]
+func (p *algebraicParser) parseExpr() (linearExpr, error) {
+	expr := linearExpr{coefs: make(map[int]float64)}
+	first := true
+
+	for {
+		sign := 1.0
+		t := p.peek()
+		if t.kind == tokPlus || t.kind == tokMinus {
+			p.next()
+			if t.kind == tokMinus {
+				sign = -1
+			}
+		} else if !first {
+			break
+		}
+
+		coef := 1.0
+		hasNumber := false
+		t = p.peek()
+		if t.kind == tokNumber {
+			p.next()
+			coef = t.value
+			hasNumber = true
+			if p.peek().kind == tokStar {
+				p.next()
+				if p.peek().kind != tokIdent {
+					return expr, p.errorAt(p.peek(), i18n.SyntaxExpectedFactor, p.peek().describe())
+				}
+			}
+		}
+
+		t = p.peek()
+		if t.kind == tokIdent && !p.isReserved(t) {
+			p.next()
+			expr.coefs[p.variable(t.text)] += sign * coef
+		} else if hasNumber {
+			expr.constant += sign * coef
+		} else {
+			return expr, p.errorAt(t, i18n.SyntaxExpectedTerm, t.describe())
+		}
+		first = false
+	}
+
+	return expr, nil
+}
+
+// isReserved indica si el identificador es una palabra clave que no puede ser variable
+func (p *algebraicParser) isReserved(t token) bool {
+	if p.lp {
+		return isLPKeyword(t)
+	}
+	return isKeyword(t, "subject", "st", "bounds", "int", "integer", "general", "end", "free")
+}
+
+// variable devuelve el índice de la variable, registrándola si es nueva
+func (p *algebraicParser) variable(name string) int {
+	if j, ok := p.varIndex[name]; ok {
+		return j
+	}
+	j := len(p.varNames)
+	p.varIndex[name] = j
+	p.varNames = append(p.varNames, name)
+	return j
+}
+
+func relationType(t token) (string, bool) {
+	switch t.kind {
+	case tokLE:
+		return "le", true
+	case tokGE:
+		return "ge", true
+	case tokEQ:
+		return "eq", true
+	}
+	return "", false
+}
+
+// buildRequest arma el SimplexRequest con una columna por variable en orden de aparición
+func (p *algebraicParser) buildRequest() models.SimplexRequest {
+	n := len(p.varNames)
+	req := models.SimplexRequest{
+		Objective:     make([]float64, n),
+		Type:          p.objType,
+		VariableNames: p.varNames,
+	}
+	for j, c := range p.objective.coefs {
+		req.Objective[j] = c
+	}
+
+	for _, c := range p.constraints {
+		row := make([]float64, n)
+		for j, v := range c.expr.coefs {
+			row[j] = v
+		}
+		req.Constraints = append(req.Constraints, row)
+		req.RHS = append(req.RHS, c.rhs)
+		req.ConstraintTypes = append(req.ConstraintTypes, c.kind)
+		req.ConstraintNames = append(req.ConstraintNames, c.name)
+	}
+
+	if len(p.bounds) > 0 {
+		req.Bounds = make([]models.Bound, n)
+		for j, b := range p.bounds {
+			req.Bounds[j] = *b
+		}
+	}
+	if len(p.integer) > 0 {
+		req.Integer = make([]bool, n)
+		for j := range p.integer {
+			req.Integer[j] = true
+		}
+	}
+
+	return req
+}
@@ -0,0 +1,225 @@
+package formats
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"proyecto/simplex/i18n"
+)
+
+// tokenKind identifica el tipo de cada token del lenguaje algebraico
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokNewline
+	tokIdent
+	tokNumber
+	tokPlus
+	tokMinus
+	tokStar
+	tokColon
+	tokComma
+	tokLE
+	tokGE
+	tokEQ
+)
+
+// token guarda el texto leído y su posición (línea y columna, desde 1)
+type token struct {
+	kind   tokenKind
+	text   string
+	value  float64
+	line   int
+	column int
+}
+
+// SyntaxError es un error de sintaxis con la posición exacta en el texto del modelo.
+// Err es el problema encontrado, que se traduce al mostrarlo.
+type SyntaxError struct {
+	Line   int
+	Column int
+	Err    *i18n.Error
+}
+
+func newSyntaxError(line, column int, code i18n.Code, args ...any) *SyntaxError {
+	return &SyntaxError{Line: line, Column: column, Err: i18n.New(code, args...)}
+}
+
+func (e *SyntaxError) Error() string {
+	return e.Localize(i18n.DefaultLanguage)
+}
+
+// Localize devuelve el mensaje con la posición en el idioma pedido
+func (e *SyntaxError) Localize(lang string) string {
+	return i18n.Message(lang, i18n.SyntaxAt, e.Line, e.Column, i18n.Localize(e.Err, lang))
+}
+
+// describe devuelve el token como aparece en los mensajes de error (el fin del
+// modelo y de línea como códigos del catálogo, para traducirlos)
+func (t token) describe() any {
+	switch t.kind {
+	case tokEOF:
+		return i18n.TokenEOF
+	case tokNewline:
+		return i18n.TokenNewline
+	}
+	return fmt.Sprintf("'%s'", t.text)
+}
+
+// tokenize divide el texto en tokens. Los comentarios empiezan con '#' o '//'
+// y el ';' se trata como un fin de línea.
+func tokenize(src string) ([]token, error) {
+	var tokens []token
+	runes := []rune(src)
+	line, col := 1, 1
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		start := token{line: line, column: col}
+
+		switch {
+		case r == '\n' || r == ';':
+			start.kind, start.text = tokNewline, string(r)
+			tokens = append(tokens, start)
+			i++
+			if r == '\n' {
+				line, col = line+1, 1
+			} else {
+				col++
+			}
+			continue
+		case unicode.IsSpace(r):
+			i++
+			col++
+			continue
+		case r == '#' || (r == '/' && i+1 < len(runes) && runes[i+1] == '/'):
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+				col++
+			}
+			continue
+		}
+
+		length := 1
+		switch {
+		case unicode.IsLetter(r) || r == '_':
+			for i+length < len(runes) && isIdentRune(runes[i+length]) {
+				length++
+			}
+			start.kind = tokIdent
+			start.text = string(runes[i : i+length])
+			// "s.t." es una abreviatura habitual de "subject to"
+			if strings.EqualFold(start.text, "s.t.") || strings.EqualFold(start.text, "s.t") {
+				start.text = "st"
+			}
+		case unicode.IsDigit(r) || (r == '.' && i+1 < len(runes) && unicode.IsDigit(runes[i+1])):
+			var ambiguous bool
+			length, ambiguous = scanNumber(runes[i:])
+			start.kind = tokNumber
+			start.text = string(runes[i : i+length])
+			if ambiguous {
+				ident := i + length
+				for ident < len(runes) && isIdentRune(runes[ident]) {
+					ident++
+				}
+				name := string(runes[i+length : ident])
+				return nil, newSyntaxError(line, col, i18n.SyntaxAmbiguousNumber,
+					start.text, name, start.text, name, start.text, name[1:])
+			}
+			v, err := strconv.ParseFloat(start.text, 64)
+			if err != nil {
+				return nil, newSyntaxError(line, col, i18n.SyntaxInvalidNumber, start.text)
+			}
+			start.value = v
+		case r == '+':
+			start.kind = tokPlus
+		case r == '-':
+			start.kind = tokMinus
+		case r == '*':
+			start.kind = tokStar
+		case r == ':':
+			start.kind = tokColon
+		case r == ',':
+			start.kind = tokComma
+		case r == '≤':
+			start.kind = tokLE
+		case r == '≥':
+			start.kind = tokGE
+		case r == '<' || r == '>' || r == '=':
+			start.kind, length = scanRelation(runes[i:])
+		default:
+			return nil, newSyntaxError(line, col, i18n.SyntaxUnexpectedChar, r)
+		}
+
+		if start.text == "" {
+			start.text = string(runes[i : i+length])
+		}
+		tokens = append(tokens, start)
+		i += length
+		col += length
+	}
+
+	tokens = append(tokens, token{kind: tokEOF, line: line, column: col})
+	return tokens, nil
+}
+
+func isIdentRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '.' || r == '[' || r == ']'
+}
+
+// scanNumber devuelve la longitud del número al comienzo de runes (admite exponente
+// con signo). Un exponente sin signo como "2e1" es ambiguo con el coeficiente de una
+// variable e1, así que en ese caso devuelve la longitud de la mantisa y ambiguous.
+func scanNumber(runes []rune) (n int, ambiguous bool) {
+	for n < len(runes) && (unicode.IsDigit(runes[n]) || runes[n] == '.') {
+		n++
+	}
+	if n+1 < len(runes) && (runes[n] == 'e' || runes[n] == 'E') {
+		m := n + 1
+		if unicode.IsDigit(runes[m]) {
+			return n, true
+		}
+		if runes[m] == '+' || runes[m] == '-' {
+			m++
+		}
+		if m < len(runes) && unicode.IsDigit(runes[m]) {
+			for m < len(runes) && unicode.IsDigit(runes[m]) {
+				m++
+			}
+			n = m
+		}
+	}
+	return n, false
+}
+
+// scanRelation reconoce <=, =<, <, >=, =>, >, = y ==
+func scanRelation(runes []rune) (tokenKind, int) {
+	next := rune(0)
+	if len(runes) > 1 {
+		next = runes[1]
+	}
+	switch runes[0] {
+	case '<':
+		if next == '=' {
+			return tokLE, 2
+		}
+		return tokLE, 1
+	case '>':
+		if next == '=' {
+			return tokGE, 2
+		}
+		return tokGE, 1
+	}
+	switch next {
+	case '<':
+		return tokLE, 2
+	case '>':
+		return tokGE, 2
+	case '=':
+		return tokEQ, 2
+	}
+	return tokEQ, 1
+}
@@ -0,0 +1,333 @@
+package formats
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"proyecto/simplex/i18n"
+	"proyecto/simplex/models"
+)
+
+// ParseLP lee un modelo en formato CPLEX LP y lo convierte en SimplexRequest.
+//
+// Se admiten las secciones Maximize/Minimize, Subject To (también "st", "s.t."
+// y "such that"), Bounds, General, Binary y End; los comentarios empiezan con '\'.
+// Como en CPLEX, los saltos de línea no separan sentencias y el lado derecho de
+// cada restricción es una constante. Los nombres de variables usan letras,
+// dígitos y los caracteres _ . [ ].
+func ParseLP(src string) (models.SimplexRequest, error) {
+	// Quitar comentarios conservando las posiciones de línea y columna
+	lines := strings.Split(src, "\n")
+	for i, line := range lines {
+		if k := strings.IndexByte(line, '\\'); k >= 0 {
+			lines[i] = line[:k]
+		}
+	}
+
+	tokens, err := tokenize(strings.Join(lines, "\n"))
+	if err != nil {
+		return models.SimplexRequest{}, err
+	}
+	filtered := tokens[:0]
+	for _, t := range tokens {
+		if t.kind != tokNewline {
+			filtered = append(filtered, t)
+		}
+	}
+
+	p := &algebraicParser{
+		tokens:   filtered,
+		varIndex: make(map[string]int),
+		bounds:   make(map[int]*models.Bound),
+		integer:  make(map[int]bool),
+		lp:       true,
+	}
+	if err := p.parseLPModel(); err != nil {
+		return models.SimplexRequest{}, err
+	}
+	return p.buildRequest(), nil
+}
+
+// Secciones propias del formato LP (además de restricciones y cotas)
+const (
+	sectionGeneral = iota + sectionBounds + 1
+	sectionBinary
+)
+
+// isLPKeyword indica si el token abre una sección del formato LP
+func isLPKeyword(t token) bool {
+	return isKeyword(t, "subject", "such", "st", "bounds", "bound", "general", "generals", "gen",
+		"integer", "integers", "binary", "binaries", "bin", "end", "free", "inf", "infinity")
+}
+
+func (p *algebraicParser) parseLPModel() error {
+	t := p.next()
+	switch {
+	case isKeyword(t, "maximize", "maximise", "maximum", "max"):
+		p.objType = "max"
+	case isKeyword(t, "minimize", "minimise", "minimum", "min"):
+		p.objType = "min"
+	default:
+		return p.errorAt(t, i18n.SyntaxModelStart, "Maximize", "Minimize", t.describe())
+	}
+
+	// Nombre opcional de la función objetivo ("obj:")
+	if p.peek().kind == tokIdent && p.tokens[p.pos+1].kind == tokColon {
+		p.pos += 2
+	}
+	start := p.peek()
+	objective, err := p.parseExpr()
+	if err != nil {
+		return err
+	}
+	if len(objective.coefs) == 0 {
+		return p.errorAt(start, i18n.SyntaxEmptyObjective)
+	}
+	if objective.constant != 0 {
+		return p.errorAt(start, i18n.SyntaxObjectiveConstant)
+	}
+	p.objective = objective
+
+	if t := p.peek(); !isKeyword(t, "subject", "such", "st") {
+		return p.errorAt(t, i18n.SyntaxExpectedSubjectTo, t.describe())
+	}
+
+	section := sectionConstraints
+	for {
+		t := p.peek()
+		switch {
+		case t.kind == tokEOF:
+			return p.errorAt(t, i18n.SyntaxMissingEnd, "End")
+		case isKeyword(t, "subject", "such"):
+			p.next()
+			if next := p.next(); !isKeyword(next, "to", "that") {
+				return p.errorAt(next, i18n.SyntaxExpectedSubjectTo, next.describe())
+			}
+			section = sectionConstraints
+		case isKeyword(t, "st"):
+			p.next()
+			section = sectionConstraints
+		case isKeyword(t, "bounds", "bound"):
+			p.next()
+			section = sectionBounds
+		case isKeyword(t, "general", "generals", "gen", "integer", "integers"):
+			p.next()
+			section = sectionGeneral
+		case isKeyword(t, "binary", "binaries", "bin"):
+			p.next()
+			section = sectionBinary
+		case isKeyword(t, "end"):
+			p.next()
+			if t := p.peek(); t.kind != tokEOF {
+				return p.errorAt(t, i18n.SyntaxTrailingContent, "End")
+			}
+			if len(p.constraints) == 0 {
+				return p.errorAt(t, i18n.SyntaxNoConstraints)
+			}
+			return nil
+		case section == sectionConstraints:
+			if err := p.parseLPConstraint(); err != nil {
+				return err
+			}
+		case section == sectionBounds:
+			if err := p.parseBound(); err != nil {
+				return err
+			}
+		default:
+			j, err := p.boundVariable()
+			if err != nil {
+				return err
+			}
+			p.integer[j] = true
+			if section == sectionBinary {
+				lower, upper := 0.0, 1.0
+				b := p.boundFor(j)
+				b.Lower, b.Upper, b.Free = &lower, &upper, false
+			}
+		}
+	}
+}
+
+// parseLPConstraint lee "[nombre:] expresión relación constante"
+func (p *algebraicParser) parseLPConstraint() error {
+	name := ""
+	if p.peek().kind == tokIdent && p.tokens[p.pos+1].kind == tokColon {
+		name = p.next().text
+		p.next()
+	}
+
+	start := p.peek()
+	left, err := p.parseExpr()
+	if err != nil {
+		return err
+	}
+	if left.constant != 0 {
+		return p.errorAt(start, i18n.SyntaxConstantLeft)
+	}
+	rel := p.next()
+	kind, ok := relationType(rel)
+	if !ok {
+		return p.errorAt(rel, i18n.SyntaxExpectedRelation, rel.describe())
+	}
+	valueToken := p.peek()
+	rhs, err := p.parseBoundValue()
+	if err != nil {
+		return err
+	}
+	if math.IsInf(rhs, 0) {
+		return p.errorAt(valueToken, i18n.SyntaxInfiniteRHS)
+	}
+
+	// Una fila con todos los coeficientes en cero ("0 x1 <= 5") es válida si nombra
+	// alguna variable: es la forma en que WriteLP escribe las filas vacías
+	if len(left.coefs) == 0 {
+		return p.errorAt(start, i18n.SyntaxEmptyConstraint)
+	}
+	for j, c := range left.coefs {
+		if c == 0 {
+			delete(left.coefs, j)
+		}
+	}
+	return p.addConstraint(start, name, left.coefs, kind, rhs)
+}
+
+// WriteLP escribe el modelo en formato CPLEX LP. Los nombres de variables y
+// restricciones deben ser identificadores válidos (letras, dígitos, _ . [ ]
+// sin empezar con dígito ni punto).
+func WriteLP(w io.Writer, req models.SimplexRequest, name string) error {
+	n := len(req.Objective)
+	varNames := make([]string, n)
+	for j := range varNames {
+		varNames[j] = modelVariableName(req, j)
+	}
+	rowNames := make([]string, len(req.Constraints))
+	for i := range rowNames {
+		rowNames[i] = modelConstraintName(req, i)
+	}
+	if len(req.ConstraintTypes) != len(req.Constraints) || len(req.RHS) != len(req.Constraints) {
+		return i18n.New(i18n.ConstraintSizes)
+	}
+	for _, s := range append(append([]string{"obj"}, varNames...), rowNames...) {
+		if !validLPName(s) {
+			return i18n.New(i18n.WriteInvalidName, "LP", s)
+		}
+	}
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "\\ Problem name: %s\n\n", name)
+
+	if req.Type == "max" {
+		bw.WriteString("Maximize\n")
+	} else {
+		bw.WriteString("Minimize\n")
+	}
+	bw.WriteString(" obj: " + lpExpression(req.Objective, varNames) + "\n")
+
+	bw.WriteString("Subject To\n")
+	for i, row := range req.Constraints {
+		op, ok := map[string]string{"le": "<=", "ge": ">=", "eq": "="}[req.ConstraintTypes[i]]
+		if !ok {
+			return i18n.New(i18n.UnknownConstraint, req.ConstraintTypes[i])
+		}
+		fmt.Fprintf(bw, " %s: %s %s %s\n", rowNames[i], lpExpression(row, varNames), op, lpNumber(req.RHS[i]))
+	}
+
+	var bounds []string
+	for j, b := range req.Bounds {
+		if j >= n {
+			break
+		}
+		v := varNames[j]
+		switch {
+		case b.Free && b.Upper == nil:
+			bounds = append(bounds, v+" free")
+		case b.Free:
+			bounds = append(bounds, fmt.Sprintf("-inf <= %s <= %s", v, lpNumber(*b.Upper)))
+		case b.Lower != nil && b.Upper != nil && *b.Lower == *b.Upper:
+			bounds = append(bounds, fmt.Sprintf("%s = %s", v, lpNumber(*b.Lower)))
+		case b.Lower != nil && b.Upper != nil:
+			bounds = append(bounds, fmt.Sprintf("%s <= %s <= %s", lpNumber(*b.Lower), v, lpNumber(*b.Upper)))
+		case b.Lower != nil && *b.Lower != 0:
+			bounds = append(bounds, fmt.Sprintf("%s >= %s", v, lpNumber(*b.Lower)))
+		case b.Upper != nil:
+			bounds = append(bounds, fmt.Sprintf("%s <= %s", v, lpNumber(*b.Upper)))
+		}
+	}
+	if len(bounds) > 0 {
+		bw.WriteString("Bounds\n")
+		for _, b := range bounds {
+			bw.WriteString(" " + b + "\n")
+		}
+	}
+
+	var general []string
+	for j, isInt := range req.Integer {
+		if isInt && j < n {
+			general = append(general, varNames[j])
+		}
+	}
+	if len(general) > 0 {
+		bw.WriteString("General\n " + strings.Join(general, " ") + "\n")
+	}
+
+	bw.WriteString("End\n")
+	return bw.Flush()
+}
+
+// lpExpression escribe una combinación lineal ("3 x + 5 y - z"), cortando la
+// línea cada 8 términos. Si no hay términos escribe "0 x1", porque ParseLP no acepta
+// una expresión vacía ni en el objetivo ni en una restricción.
+func lpExpression(coefs []float64, names []string) string {
+	var sb strings.Builder
+	terms := 0
+	for j, c := range coefs {
+		if c == 0 || j >= len(names) {
+			continue
+		}
+		if terms > 0 && terms%8 == 0 {
+			sb.WriteString("\n     ")
+		}
+		switch {
+		case terms == 0 && c < 0:
+			sb.WriteString("- ")
+		case terms > 0 && c < 0:
+			sb.WriteString(" - ")
+		case terms > 0:
+			sb.WriteString(" + ")
+		}
+		if a := math.Abs(c); a != 1 {
+			sb.WriteString(lpNumber(a) + " ")
+		}
+		sb.WriteString(names[j])
+		terms++
+	}
+	if terms == 0 && len(names) > 0 {
+		return "0 " + names[0]
+	}
+	return sb.String()
+}
+
+func lpNumber(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+// validLPName verifica que el nombre se pueda volver a leer como identificador
+func validLPName(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i, r := range s {
+		if i == 0 && !unicode.IsLetter(r) && r != '_' {
+			return false
+		}
+		if !isIdentRune(r) {
+			return false
+		}
+	}
+	return !isLPKeyword(token{kind: tokIdent, text: s})
+}
@@ -0,0 +1,628 @@
+package formats
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"proyecto/simplex/i18n"
+	"proyecto/simplex/models"
+)
+
+// MPSError es un error de lectura de un archivo MPS con el número de línea. Err es el
+// problema encontrado, que se traduce al mostrarlo.
+type MPSError struct {
+	Line int
+	Err  *i18n.Error
+}
+
+func (e *MPSError) Error() string {
+	return e.Localize(i18n.DefaultLanguage)
+}
+
+// Localize devuelve el mensaje con el número de línea en el idioma pedido
+func (e *MPSError) Localize(lang string) string {
+	return i18n.Message(lang, i18n.MPSAt, e.Line, i18n.Localize(e.Err, lang))
+}
+
+// mpsRow es una fila declarada en la sección ROWS
+type mpsRow struct {
+	name  string
+	kind  string // N, L, G o E
+	index int    // índice de la restricción (-1 para filas N)
+}
+
+type mpsReader struct {
+	fixed bool
+	line  int
+
+	objType   string
+	objRow    string
+	rows      map[string]*mpsRow
+	rowOrder  []string
+	colIndex  map[string]int
+	colNames  []string
+	objective map[int]float64
+	coefs     []map[int]float64 // coeficientes por restricción
+	rhs       []float64
+	ranges    map[int]float64
+	bounds    map[int]*models.Bound
+	integer   map[int]bool
+	inInteger bool
+}
+
+// ParseMPS lee un modelo en formato MPS y lo convierte en SimplexRequest.
+// Con fixed=true los campos se leen por posición (columnas 2-3, 5-12, 15-22,
+// 25-36, 40-47 y 50-61); si no, se separan por espacios (MPS libre).
+// Se admiten las secciones NAME, OBJSENSE, ROWS, COLUMNS (con marcadores
+// INTORG/INTEND), RHS, RANGES, BOUNDS y ENDATA. Sin OBJSENSE el modelo es de minimización.
+func ParseMPS(r io.Reader, fixed bool) (models.SimplexRequest, error) {
+	m := &mpsReader{
+		fixed:     fixed,
+		objType:   "min",
+		rows:      make(map[string]*mpsRow),
+		colIndex:  make(map[string]int),
+		objective: make(map[int]float64),
+		ranges:    make(map[int]float64),
+		bounds:    make(map[int]*models.Bound),
+		integer:   make(map[int]bool),
+	}
+
+	scanner := bufio.NewScanner(r)
+	section := ""
+	ended := false
+	for scanner.Scan() {
+		m.line++
+		raw := strings.TrimRight(scanner.Text(), "\r")
+		if strings.TrimSpace(raw) == "" || strings.HasPrefix(raw, "*") {
+			continue
+		}
+
+		// Los encabezados de sección empiezan en la columna 1
+		if raw[0] != ' ' && raw[0] != '\t' {
+			fields := strings.Fields(raw)
+			section = strings.ToUpper(fields[0])
+			switch section {
+			case "NAME", "ROWS", "COLUMNS", "RHS", "RANGES", "BOUNDS":
+			case "OBJSENSE":
+				if len(fields) > 1 {
+					if err := m.setSense(fields[1]); err != nil {
+						return models.SimplexRequest{}, err
+					}
+				}
+			case "ENDATA":
+				ended = true
+			default:
+				return models.SimplexRequest{}, m.errorf(i18n.MPSUnknownSection, fields[0])
+			}
+			if ended {
+				break
+			}
+			continue
+		}
+
+		fields := m.fields(raw)
+		if len(fields) == 0 {
+			continue
+		}
+
+		var err error
+		switch section {
+		case "OBJSENSE":
+			err = m.setSense(fields[0])
+		case "ROWS":
+			err = m.readRow(fields)
+		case "COLUMNS":
+			err = m.readColumn(fields)
+		case "RHS":
+			err = m.readRHS(fields)
+		case "RANGES":
+			err = m.readRange(fields)
+		case "BOUNDS":
+			err = m.readBound(fields)
+		default:
+			err = m.errorf(i18n.MPSOutsideSection)
+		}
+		if err != nil {
+			return models.SimplexRequest{}, err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return models.SimplexRequest{}, err
+	}
+	if !ended {
+		return models.SimplexRequest{}, m.errorf(i18n.MPSMissingEndata)
+	}
+	if m.objRow == "" {
+		return models.SimplexRequest{}, m.errorf(i18n.MPSNoObjective)
+	}
+
+	return m.buildRequest(), nil
+}
+
+func (m *mpsReader) errorf(code i18n.Code, args ...any) error {
+	return &MPSError{Line: m.line, Err: i18n.New(code, args...)}
+}
+
+// fields separa una línea de datos en campos según el formato (fijo o libre)
+func (m *mpsReader) fields(raw string) []string {
+	if !m.fixed {
+		return strings.Fields(raw)
+	}
+
+	// Columnas de inicio y fin (1-based) de cada campo del MPS fijo
+	positions := [][2]int{{2, 3}, {5, 12}, {15, 22}, {25, 36}, {40, 47}, {50, 61}}
+	var fields []string
+	for i, pos := range positions {
+		if pos[0] > len(raw) {
+			break
+		}
+		end := pos[1]
+		if end > len(raw) {
+			end = len(raw)
+		}
+		field := strings.TrimSpace(raw[pos[0]-1 : end])
+		// El primer campo (tipo) puede estar vacío en COLUMNS, RHS y RANGES
+		if field == "" && i == 0 {
+			continue
+		}
+		fields = append(fields, field)
+	}
+	for len(fields) > 0 && fields[len(fields)-1] == "" {
+		fields = fields[:len(fields)-1]
+	}
+	return fields
+}
+
+func (m *mpsReader) setSense(value string) error {
+	switch strings.ToUpper(value) {
+	case "MAX", "MAXIMIZE":
+		m.objType = "max"
+	case "MIN", "MINIMIZE":
+		m.objType = "min"
+	default:
+		return m.errorf(i18n.MPSObjSense, value)
+	}
+	return nil
+}
+
+func (m *mpsReader) readRow(fields []string) error {
+	if len(fields) != 2 {
+		return m.errorf(i18n.MPSRowFields)
+	}
+	kind, name := strings.ToUpper(fields[0]), fields[1]
+	if _, ok := m.rows[name]; ok {
+		return m.errorf(i18n.MPSDuplicateRow, name)
+	}
+
+	row := &mpsRow{name: name, kind: kind, index: -1}
+	switch kind {
+	case "N":
+		if m.objRow == "" {
+			m.objRow = name
+		}
+	case "L", "G", "E":
+		row.index = len(m.coefs)
+		m.coefs = append(m.coefs, make(map[int]float64))
+		m.rhs = append(m.rhs, 0)
+		m.rowOrder = append(m.rowOrder, name)
+	default:
+		return m.errorf(i18n.MPSRowType, fields[0])
+	}
+	m.rows[name] = row
+	return nil
+}
+
+func (m *mpsReader) readColumn(fields []string) error {
+	// Marcadores de variables enteras: nombre 'MARKER' 'INTORG' | 'INTEND'
+	if len(fields) >= 3 && strings.Trim(fields[1], "'") == "MARKER" {
+		switch marker := strings.Trim(fields[len(fields)-1], "'"); marker {
+		case "INTORG":
+			m.inInteger = true
+		case "INTEND":
+			m.inInteger = false
+		default:
+			return m.errorf(i18n.MPSMarker, marker)
+		}
+		return nil
+	}
+
+	if len(fields) != 3 && len(fields) != 5 {
+		return m.errorf(i18n.MPSColumnFields)
+	}
+
+	name := fields[0]
+	j, ok := m.colIndex[name]
+	if !ok {
+		j = len(m.colNames)
+		m.colIndex[name] = j
+		m.colNames = append(m.colNames, name)
+	}
+	if m.inInteger {
+		m.integer[j] = true
+	}
+
+	for k := 1; k+1 < len(fields); k += 2 {
+		row, ok := m.rows[fields[k]]
+		if !ok {
+			return m.errorf(i18n.MPSUnknownRow, fields[k])
+		}
+		v, err := m.number(fields[k+1])
+		if err != nil {
+			return err
+		}
+		if row.kind == "N" {
+			if row.name == m.objRow {
+				m.objective[j] += v
+			}
+			continue
+		}
+		m.coefs[row.index][j] += v
+	}
+	return nil
+}
+
+// pairs devuelve los pares (fila, valor) de una línea de RHS o RANGES,
+// con o sin el nombre del conjunto al principio
+func (m *mpsReader) pairs(fields []string, section string) ([]string, error) {
+	switch len(fields) {
+	case 3, 5:
+		return fields[1:], nil
+	case 2, 4:
+		return fields, nil
+	}
+	return nil, m.errorf(i18n.MPSValueFields, section)
+}
+
+func (m *mpsReader) readRHS(fields []string) error {
+	pairs, err := m.pairs(fields, "RHS")
+	if err != nil {
+		return err
+	}
+	for k := 0; k+1 < len(pairs); k += 2 {
+		row, ok := m.rows[pairs[k]]
+		if !ok {
+			return m.errorf(i18n.MPSUnknownRow, pairs[k])
+		}
+		v, err := m.number(pairs[k+1])
+		if err != nil {
+			return err
+		}
+		// El RHS de la fila objetivo es una constante que no afecta la solución
+		if row.kind == "N" {
+			continue
+		}
+		m.rhs[row.index] = v
+	}
+	return nil
+}
+
+func (m *mpsReader) readRange(fields []string) error {
+	pairs, err := m.pairs(fields, "RANGES")
+	if err != nil {
+		return err
+	}
+	for k := 0; k+1 < len(pairs); k += 2 {
+		row, ok := m.rows[pairs[k]]
+		if !ok || row.kind == "N" {
+			return m.errorf(i18n.MPSUnknownRow, pairs[k])
+		}
+		v, err := m.number(pairs[k+1])
+		if err != nil {
+			return err
+		}
+		m.ranges[row.index] = v
+	}
+	return nil
+}
+
+func (m *mpsReader) readBound(fields []string) error {
+	if len(fields) < 2 {
+		return m.errorf(i18n.MPSBoundFields)
+	}
+	kind := strings.ToUpper(fields[0])
+	needsValue := kind != "FR" && kind != "MI" && kind != "PL" && kind != "BV"
+
+	// El nombre del conjunto es opcional en MPS libre
+	args := fields[1:]
+	expected := 1
+	if needsValue {
+		expected = 2
+	}
+	if len(args) == expected+1 {
+		args = args[1:]
+	} else if len(args) != expected {
+		return m.errorf(i18n.MPSBoundFieldCount, kind)
+	}
+
+	j, ok := m.colIndex[args[0]]
+	if !ok {
+		return m.errorf(i18n.MPSUnknownColumn, args[0])
+	}
+	value := 0.0
+	if needsValue {
+		v, err := m.number(args[1])
+		if err != nil {
+			return err
+		}
+		value = v
+	}
+
+	b, ok := m.bounds[j]
+	if !ok {
+		b = &models.Bound{}
+		m.bounds[j] = b
+	}
+	switch kind {
+	case "UP", "UI":
+		b.Upper = &value
+		m.integer[j] = m.integer[j] || kind == "UI"
+	case "LO", "LI":
+		b.Lower = &value
+		b.Free = false
+		m.integer[j] = m.integer[j] || kind == "LI"
+	case "FX":
+		lower, upper := value, value
+		b.Lower, b.Upper, b.Free = &lower, &upper, false
+	case "FR":
+		b.Lower, b.Upper, b.Free = nil, nil, true
+	case "MI":
+		b.Lower, b.Free = nil, true
+	case "PL":
+		b.Upper = nil
+	case "BV":
+		lower, upper := 0.0, 1.0
+		b.Lower, b.Upper, b.Free = &lower, &upper, false
+		m.integer[j] = true
+	default:
+		return m.errorf(i18n.MPSBoundType, fields[0])
+	}
+	return nil
+}
+
+func (m *mpsReader) number(s string) (float64, error) {
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsNaN(v) {
+		return 0, m.errorf(i18n.MPSInvalidNumber, s)
+	}
+	return v, nil
+}
+
+// buildRequest arma el SimplexRequest; los RANGES agregan una segunda restricción
+// con el otro extremo del intervalo.
+func (m *mpsReader) buildRequest() models.SimplexRequest {
+	n := len(m.colNames)
+	req := models.SimplexRequest{
+		Objective:     make([]float64, n),
+		Type:          m.objType,
+		VariableNames: m.colNames,
+	}
+	for j, v := range m.objective {
+		req.Objective[j] = v
+	}
+
+	addRow := func(name string, coefs map[int]float64, kind string, rhs float64) {
+		row := make([]float64, n)
+		for j, v := range coefs {
+			row[j] = v
+		}
+		req.Constraints = append(req.Constraints, row)
+		req.ConstraintTypes = append(req.ConstraintTypes, kind)
+		req.RHS = append(req.RHS, rhs)
+		req.ConstraintNames = append(req.ConstraintNames, name)
+	}
+
+	for i, name := range m.rowOrder {
+		row := m.rows[name]
+		kind := map[string]string{"L": "le", "G": "ge", "E": "eq"}[row.kind]
+		rhs := m.rhs[i]
+
+		r, ranged := m.ranges[i]
+		if !ranged {
+			addRow(name, m.coefs[i], kind, rhs)
+			continue
+		}
+
+		// Intervalo [lo, hi] según el tipo de fila y el signo del rango
+		lo, hi := rhs, rhs
+		switch {
+		case row.kind == "L":
+			lo = rhs - math.Abs(r)
+		case row.kind == "G":
+			hi = rhs + math.Abs(r)
+		case r >= 0:
+			hi = rhs + r
+		default:
+			lo = rhs + r
+		}
+		addRow(name, m.coefs[i], "ge", lo)
+		addRow(name+"_rng", m.coefs[i], "le", hi)
+	}
+
+	if len(m.bounds) > 0 {
+		req.Bounds = make([]models.Bound, n)
+		for j, b := range m.bounds {
+			req.Bounds[j] = *b
+		}
+	}
+	if len(m.integer) > 0 {
+		req.Integer = make([]bool, n)
+		for j := range m.integer {
+			req.Integer[j] = m.integer[j]
+		}
+	}
+
+	return req
+}
+
+// WriteMPS escribe el modelo en formato MPS. Con fixed=true los campos se
+// alinean en las columnas del MPS fijo y los nombres deben tener hasta 8
+// caracteres; en ambos formatos los nombres no pueden contener espacios.
+func WriteMPS(w io.Writer, req models.SimplexRequest, name string, fixed bool) error {
+	n := len(req.Objective)
+	varNames := make([]string, n)
+	for j := range varNames {
+		varNames[j] = modelVariableName(req, j)
+	}
+	rowNames := make([]string, len(req.Constraints))
+	for i := range rowNames {
+		rowNames[i] = modelConstraintName(req, i)
+	}
+	if len(req.ConstraintTypes) != len(req.Constraints) || len(req.RHS) != len(req.Constraints) {
+		return i18n.New(i18n.ConstraintSizes)
+	}
+
+	names := append(append([]string{name, "OBJ"}, varNames...), rowNames...)
+	for _, s := range names {
+		if s == "" || strings.ContainsAny(s, " \t") {
+			return i18n.New(i18n.WriteInvalidName, "MPS", s)
+		}
+		if fixed && len(s) > 8 {
+			return i18n.New(i18n.WriteNameTooLong, s)
+		}
+	}
+
+	bw := bufio.NewWriter(w)
+	line := func(fields ...string) {
+		bw.WriteString(mpsLine(fixed, fields...))
+		bw.WriteString("\n")
+	}
+
+	fmt.Fprintf(bw, "NAME          %s\n", name)
+	if req.Type == "max" {
+		bw.WriteString("OBJSENSE\n    MAX\n")
+	}
+
+	bw.WriteString("ROWS\n")
+	line("N", "OBJ")
+	for i, t := range req.ConstraintTypes {
+		kind, ok := map[string]string{"le": "L", "ge": "G", "eq": "E"}[t]
+		if !ok {
+			return i18n.New(i18n.UnknownConstraint, t)
+		}
+		line(kind, rowNames[i])
+	}
+
+	bw.WriteString("COLUMNS\n")
+	inInteger := false
+	for j := 0; j < n; j++ {
+		isInt := j < len(req.Integer) && req.Integer[j]
+		if isInt != inInteger {
+			marker := "'INTEND'"
+			if isInt {
+				marker = "'INTORG'"
+			}
+			line("", "MARKER", "'MARKER'", "", marker)
+			inInteger = isInt
+		}
+
+		var entries []string
+		if req.Objective[j] != 0 {
+			entries = append(entries, "OBJ", formatMPSNumber(req.Objective[j], fixed))
+		}
+		for i, row := range req.Constraints {
+			if j < len(row) && row[j] != 0 {
+				entries = append(entries, rowNames[i], formatMPSNumber(row[j], fixed))
+			}
+		}
+		// Una columna sin coeficientes igual debe declararse
+		if len(entries) == 0 {
+			entries = append(entries, "OBJ", formatMPSNumber(0, fixed))
+		}
+		for k := 0; k < len(entries); k += 4 {
+			end := k + 4
+			if end > len(entries) {
+				end = len(entries)
+			}
+			line(append([]string{"", varNames[j]}, entries[k:end]...)...)
+		}
+	}
+	if inInteger {
+		line("", "MARKER", "'MARKER'", "", "'INTEND'")
+	}
+
+	bw.WriteString("RHS\n")
+	for i, v := range req.RHS {
+		if v != 0 {
+			line("", "RHS", rowNames[i], formatMPSNumber(v, fixed))
+		}
+	}
+
+	if len(req.Bounds) > 0 {
+		bw.WriteString("BOUNDS\n")
+		for j, b := range req.Bounds {
+			if j >= n {
+				break
+			}
+			switch {
+			case b.Free && b.Upper == nil:
+				line("FR", "BND", varNames[j])
+			case b.Free:
+				line("MI", "BND", varNames[j])
+			case b.Lower != nil && b.Upper != nil && *b.Lower == *b.Upper:
+				line("FX", "BND", varNames[j], formatMPSNumber(*b.Lower, fixed))
+				continue
+			case b.Lower != nil && *b.Lower != 0:
+				line("LO", "BND", varNames[j], formatMPSNumber(*b.Lower, fixed))
+			}
+			if b.Upper != nil {
+				line("UP", "BND", varNames[j], formatMPSNumber(*b.Upper, fixed))
+			}
+		}
+	}
+
+	bw.WriteString("ENDATA\n")
+	return bw.Flush()
+}
+
+// mpsLine arma una línea de datos. En MPS fijo cada campo empieza en su columna;
+// en MPS libre los campos se separan con espacios.
+func mpsLine(fixed bool, fields ...string) string {
+	if !fixed {
+		var parts []string
+		for _, f := range fields {
+			if f != "" {
+				parts = append(parts, f)
+			}
+		}
+		return " " + strings.Join(parts, " ")
+	}
+
+	starts := []int{1, 4, 14, 24, 39, 49}
+	var sb strings.Builder
+	for i, f := range fields {
+		for sb.Len() < starts[i] {
+			sb.WriteByte(' ')
+		}
+		sb.WriteString(f)
+	}
+	return strings.TrimRight(sb.String(), " ")
+}
+
+// formatMPSNumber formatea un número; en MPS fijo debe ocupar a lo sumo 12 caracteres
+func formatMPSNumber(v float64, fixed bool) string {
+	s := strconv.FormatFloat(v, 'g', -1, 64)
+	for prec := 12; fixed && len(s) > 12 && prec > 0; prec-- {
+		s = strconv.FormatFloat(v, 'g', prec, 64)
+	}
+	return s
+}
+
+// modelVariableName devuelve el nombre de la variable j del modelo (x1, x2... por defecto)
+func modelVariableName(req models.SimplexRequest, j int) string {
+	if j < len(req.VariableNames) && req.VariableNames[j] != "" {
+		return req.VariableNames[j]
+	}
+	return fmt.Sprintf("x%d", j+1)
+}
+
+// modelConstraintName devuelve el nombre de la restricción i (c1, c2... por defecto)
+func modelConstraintName(req models.SimplexRequest, i int) string {
+	if i < len(req.ConstraintNames) && req.ConstraintNames[i] != "" {
+		return req.ConstraintNames[i]
+	}
+	return fmt.Sprintf("c%d", i+1)
+}
@@ -0,0 +1,248 @@
+package formats
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"proyecto/simplex/i18n"
+	"proyecto/simplex/logic"
+	"proyecto/simplex/models"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// CellError indica una celda inválida de la planilla (fila desde 1, columna en letras;
+// sin columna si el problema es de toda la fila). Err se traduce al mostrarlo.
+type CellError struct {
+	Row    int
+	Column string
+	Err    *i18n.Error
+}
+
+func newCellError(row int, column string, code i18n.Code, args ...any) CellError {
+	return CellError{Row: row, Column: column, Err: i18n.New(code, args...)}
+}
+
+func (e CellError) Error() string {
+	return e.Localize(i18n.DefaultLanguage)
+}
+
+// Localize devuelve el mensaje con la posición de la celda en el idioma pedido
+func (e CellError) Localize(lang string) string {
+	if e.Column == "" {
+		return i18n.Message(lang, i18n.SheetRow, e.Row, i18n.Localize(e.Err, lang))
+	}
+	return i18n.Message(lang, i18n.SheetCell, e.Row, e.Column, i18n.Localize(e.Err, lang))
+}
+
+// SheetErrors agrupa todos los errores de celda encontrados en una planilla
+type SheetErrors []CellError
+
+func (e SheetErrors) Error() string {
+	return e.Localize(i18n.DefaultLanguage)
+}
+
+// Localize devuelve todos los errores en el idioma pedido, separados por "; "
+func (e SheetErrors) Localize(lang string) string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Localize(lang)
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// ReadCSV lee un CSV separado por ',' o ';' (se detecta en la primera línea).
+func ReadCSV(r io.Reader) ([][]string, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf")) // BOM de Excel
+
+	firstLine := data
+	if k := bytes.IndexByte(data, '\n'); k >= 0 {
+		firstLine = data[:k]
+	}
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	if bytes.Count(firstLine, []byte(";")) > bytes.Count(firstLine, []byte(",")) {
+		reader.Comma = ';'
+	}
+	return reader.ReadAll()
+}
+
+// ReadXLSX lee las celdas de una hoja de un libro XLSX (la primera si sheet está vacío).
+func ReadXLSX(r io.Reader, sheet string) ([][]string, error) {
+	f, err := excelize.OpenReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	if sheet == "" {
+		sheet = f.GetSheetName(0)
+	}
+	return f.GetRows(sheet, excelize.Options{RawCellValue: true})
+}
+
+// ParseSpreadsheet convierte las celdas de una planilla en un SimplexRequest validado.
+//
+// Formato esperado:
+//
+//	     A           B    C    ...  type  rhs
+//	1    max         x1   x2   ...  type  rhs    <- encabezado: max/min y nombres de variables
+//	2    objetivo    3    5                      <- coeficientes de la función objetivo
+//	3    c1          1    0         <=    4      <- una fila por restricción
+//	4    c2          0    2         <=    12
+//
+// La columna A tiene el sentido (max/min) en el encabezado y el nombre de cada
+// restricción en las demás filas. Las columnas "type" (o "tipo") y "rhs" se
+// ubican por su encabezado. Las celdas de coeficientes vacías valen 0 y el tipo
+// admite <=, >=, =, le, ge y eq. Se informan todos los errores de celda juntos.
+func ParseSpreadsheet(rows [][]string) (models.SimplexRequest, error) {
+	var errs SheetErrors
+	cell := func(row []string, j int) string {
+		if j < len(row) {
+			return strings.TrimSpace(row[j])
+		}
+		return ""
+	}
+
+	// Descartar filas vacías conservando el número de fila original
+	var lines []int
+	for i, row := range rows {
+		if strings.TrimSpace(strings.Join(row, "")) != "" {
+			lines = append(lines, i)
+		}
+	}
+	if len(lines) < 3 {
+		return models.SimplexRequest{}, SheetErrors{newCellError(len(rows)+1, "", i18n.SheetTooShort)}
+	}
+
+	header := rows[lines[0]]
+	headerRow := lines[0] + 1
+	req := models.SimplexRequest{}
+
+	switch strings.ToLower(cell(header, 0)) {
+	case "max", "maximize", "maximizar":
+		req.Type = "max"
+	case "min", "minimize", "minimizar":
+		req.Type = "min"
+	default:
+		errs = append(errs, newCellError(headerRow, columnName(0), i18n.SheetType, cell(header, 0)))
+	}
+
+	typeCol, rhsCol := -1, -1
+	for j := range header {
+		switch strings.ToLower(cell(header, j)) {
+		case "type", "tipo":
+			typeCol = j
+		case "rhs":
+			rhsCol = j
+		}
+	}
+	if typeCol == -1 || rhsCol == -1 || typeCol < 2 || rhsCol < 2 {
+		errs = append(errs, newCellError(headerRow, "", i18n.SheetHeader))
+		return models.SimplexRequest{}, errs
+	}
+
+	numVariables := typeCol
+	if rhsCol < numVariables {
+		numVariables = rhsCol
+	}
+	numVariables-- // la columna A no es una variable
+	for j := 1; j <= numVariables; j++ {
+		name := cell(header, j)
+		if name == "" {
+			name = fmt.Sprintf("x%d", j)
+		}
+		req.VariableNames = append(req.VariableNames, name)
+	}
+
+	// numbers lee los coeficientes de una fila y registra las celdas no numéricas
+	numbers := func(line int) []float64 {
+		values := make([]float64, numVariables)
+		for j := 1; j <= numVariables; j++ {
+			v, err := parseCellNumber(cell(rows[line], j))
+			if err != nil {
+				errs = append(errs, newCellError(line+1, columnName(j), i18n.SheetNotNumeric, cell(rows[line], j)))
+			}
+			values[j-1] = v
+		}
+		return values
+	}
+
+	req.Objective = numbers(lines[1])
+
+	for _, line := range lines[2:] {
+		row := rows[line]
+		req.Constraints = append(req.Constraints, numbers(line))
+
+		name := cell(row, 0)
+		if name == "" {
+			name = fmt.Sprintf("c%d", len(req.Constraints))
+		}
+		req.ConstraintNames = append(req.ConstraintNames, name)
+
+		kind, ok := constraintKind(cell(row, typeCol))
+		if !ok {
+			errs = append(errs, newCellError(line+1, columnName(typeCol), i18n.SheetConstraintType, cell(row, typeCol)))
+		}
+		req.ConstraintTypes = append(req.ConstraintTypes, kind)
+
+		rhsText := cell(row, rhsCol)
+		rhs, err := parseCellNumber(rhsText)
+		if err != nil || rhsText == "" {
+			errs = append(errs, newCellError(line+1, columnName(rhsCol), i18n.SheetNotNumeric, rhsText))
+		}
+		req.RHS = append(req.RHS, rhs)
+	}
+
+	if len(errs) > 0 {
+		return models.SimplexRequest{}, errs
+	}
+	if err := logic.ValidarEntrada(req.Objective, req.Constraints, req.RHS); err != nil {
+		return models.SimplexRequest{}, err
+	}
+	return req, nil
+}
+
+// parseCellNumber convierte el texto de una celda; vacía vale 0 y se acepta coma decimal
+func parseCellNumber(s string) (float64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil && strings.Count(s, ",") == 1 && !strings.Contains(s, ".") {
+		v, err = strconv.ParseFloat(strings.Replace(s, ",", ".", 1), 64)
+	}
+	return v, err
+}
+
+// constraintKind traduce el tipo de restricción de la planilla a le/ge/eq
+func constraintKind(s string) (string, bool) {
+	switch strings.ToLower(s) {
+	case "<=", "=<", "≤", "<", "le":
+		return "le", true
+	case ">=", "=>", "≥", ">", "ge":
+		return "ge", true
+	case "=", "==", "eq":
+		return "eq", true
+	}
+	return "", false
+}
+
+// columnName convierte un índice de columna (desde 0) en letras de planilla: A, B... Z, AA
+func columnName(j int) string {
+	name, err := excelize.ColumnNumberToName(j + 1)
+	if err != nil {
+		return strconv.Itoa(j + 1)
+	}
+	return name
+}
@@ -0,0 +1,29 @@
+package handlers
+
+import (
+	"net/http"
+
+	"proyecto/simplex/i18n"
+	"proyecto/simplex/logic"
+	"proyecto/simplex/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AssignmentHandler resuelve un problema de asignación con el método húngaro y
+// devuelve cada reducción, cubrimiento y ajuste de la matriz
+func AssignmentHandler(c *gin.Context) {
+	var req models.AssignmentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, http.StatusBadRequest, i18n.New(i18n.InvalidJSON, err.Error()), headerLanguage(c))
+		return
+	}
+	lang := fillLanguage(c, &req.Language)
+
+	response, err := logic.SolveAssignment(req)
+	if err != nil {
+		respondModelError(c, err, lang)
+		return
+	}
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,26 @@
+package handlers
+
+import (
+	"net/http"
+
+	"proyecto/simplex/logic"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DualHandler devuelve el dual del modelo recibido (mismo cuerpo que /api/simplex;
+// admite igualdades y variables libres). Con ?solve=true resuelve el primal y el
+// dual y verifica dualidad fuerte y holgura complementaria.
+func DualHandler(c *gin.Context) {
+	req, ok := bindModel(c)
+	if !ok {
+		return
+	}
+
+	response, err := logic.BuildDual(req, c.Query("solve") == "true")
+	if err != nil {
+		respondModelError(c, err, req.Language)
+		return
+	}
+	c.JSON(http.StatusOK, response)
+}
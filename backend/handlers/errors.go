@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"proyecto/simplex/formats"
+	"proyecto/simplex/i18n"
+	"proyecto/simplex/logic"
+	"proyecto/simplex/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Códigos de los errores que no provienen del catálogo (errores de E/S, de los
+// generadores de archivos, etc.)
+const (
+	codeBadRequest    = "bad_request"
+	codeInternalError = "internal_error"
+)
+
+// respondError responde con el sobre de error {"error": {code, message, field, details}}
+// y el mensaje traducido al idioma pedido
+func respondError(c *gin.Context, status int, err error, lang string) {
+	c.JSON(status, models.ErrorResponse{Error: apiError(err, status, lang)})
+}
+
+// respondModelError responde un error del modelo: 422 si solo usa características no
+// soportadas por el solver (modelo válido que no se puede resolver) y 400 si es inválido
+func respondModelError(c *gin.Context, err error, lang string) {
+	respondError(c, modelErrorStatus(err), err, lang)
+}
+
+func modelErrorStatus(err error) int {
+	var u interface{ Unsupported() bool }
+	if errors.As(err, &u) && u.Unsupported() {
+		return http.StatusUnprocessableEntity
+	}
+	return http.StatusBadRequest
+}
+
+// paramError es el error de un parámetro de la URL
+func paramError(name string, code i18n.Code) error {
+	return &logic.FieldError{Field: name, Err: i18n.New(code)}
+}
+
+// apiError convierte un error en el cuerpo de la respuesta. Los errores de sintaxis,
+// de MPS y de celdas conservan su código y llevan en details.reason el código del
+// catálogo del problema encontrado.
+func apiError(err error, status int, lang string) models.APIError {
+	var validationErrs logic.ValidationErrors
+	var fieldErr *logic.FieldError
+	var syntaxErr *formats.SyntaxError
+	var mpsErr *formats.MPSError
+	var sheetErrs formats.SheetErrors
+	var catalogErr *i18n.Error
+
+	switch {
+	case errors.As(err, &validationErrs) && len(validationErrs) > 1:
+		items := make([]models.APIError, len(validationErrs))
+		for i, fe := range validationErrs {
+			items[i] = fieldAPIError(fe, lang)
+		}
+		code := i18n.InvalidModel
+		if validationErrs.Unsupported() {
+			code = i18n.UnsupportedModel
+		}
+		return models.APIError{
+			Code:    string(code),
+			Message: i18n.Message(lang, code, len(validationErrs)),
+			Details: map[string]any{"errors": items},
+		}
+	case errors.As(err, &fieldErr):
+		return fieldAPIError(fieldErr, lang)
+	case errors.As(err, &syntaxErr):
+		return models.APIError{
+			Code:    "syntax_error",
+			Message: syntaxErr.Localize(lang),
+			Details: map[string]any{"line": syntaxErr.Line, "column": syntaxErr.Column, "reason": string(syntaxErr.Err.Code)},
+		}
+	case errors.As(err, &mpsErr):
+		return models.APIError{
+			Code:    "mps_error",
+			Message: mpsErr.Localize(lang),
+			Details: map[string]any{"line": mpsErr.Line, "reason": string(mpsErr.Err.Code)},
+		}
+	case errors.As(err, &sheetErrs):
+		cells := make([]map[string]any, len(sheetErrs))
+		for i, e := range sheetErrs {
+			cells[i] = map[string]any{
+				"row":     e.Row,
+				"column":  e.Column,
+				"message": i18n.Localize(e.Err, lang),
+				"reason":  string(e.Err.Code),
+			}
+		}
+		return models.APIError{Code: "invalid_cells", Message: sheetErrs.Localize(lang), Details: map[string]any{"cells": cells}}
+	case errors.As(err, &catalogErr):
+		return models.APIError{Code: string(catalogErr.Code), Message: i18n.Localize(err, lang)}
+	}
+
+	code := codeBadRequest
+	if status >= http.StatusInternalServerError {
+		code = codeInternalError
+	}
+	return models.APIError{Code: code, Message: err.Error()}
+}
+
+func fieldAPIError(fe *logic.FieldError, lang string) models.APIError {
+	return models.APIError{
+		Code:    string(fe.Err.Code),
+		Message: i18n.Localize(fe.Err, lang),
+		Field:   fe.Field,
+		Details: fe.Details,
+	}
+}
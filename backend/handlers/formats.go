@@ -0,0 +1,214 @@
+package handlers
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"proyecto/simplex/formats"
+	"proyecto/simplex/i18n"
+	"proyecto/simplex/logic"
+	"proyecto/simplex/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Estructura para recibir un modelo en notación algebraica como JSON
+type textModelRequest struct {
+	Model string `json:"model"`
+}
+
+// SolveTextHandler recibe un modelo algebraico ("max 3x + 5y subject to ..."),
+// lo convierte en SimplexRequest y lo resuelve. Acepta el texto plano en el cuerpo
+// (Content-Type: text/plain) o un JSON {"model": "..."}.
+func SolveTextHandler(c *gin.Context) {
+	var src string
+	if c.ContentType() == "text/plain" {
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			respondError(c, http.StatusBadRequest, err, headerLanguage(c))
+			return
+		}
+		src = string(body)
+	} else {
+		var req textModelRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			respondError(c, http.StatusBadRequest, i18n.New(i18n.InvalidJSON, err.Error()), headerLanguage(c))
+			return
+		}
+		src = req.Model
+	}
+
+	model, err := formats.ParseAlgebraic(src)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err, headerLanguage(c))
+		return
+	}
+	solveParsedModel(c, model)
+}
+
+// solveParsedModel resuelve un modelo importado y responde con el modelo y el resultado
+func solveParsedModel(c *gin.Context, model models.SimplexRequest) {
+	lang := resolveLanguage(c, &model)
+	result, err := logic.SolveRequest(model)
+	if err != nil {
+		status := modelErrorStatus(err)
+		c.JSON(status, gin.H{"error": apiError(err, status, lang), "model": model})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"model":  model,
+		"result": result,
+	})
+}
+
+// readModelFile devuelve el contenido y el nombre del archivo subido en el campo
+// "file" de un formulario multipart o, si no hay formulario, el cuerpo completo
+// de la petición (sin nombre).
+func readModelFile(c *gin.Context) ([]byte, string, error) {
+	if c.ContentType() == "multipart/form-data" {
+		header, err := c.FormFile("file")
+		if err != nil {
+			return nil, "", err
+		}
+		file, err := header.Open()
+		if err != nil {
+			return nil, "", err
+		}
+		defer file.Close()
+		data, err := io.ReadAll(file)
+		return data, header.Filename, err
+	}
+	data, err := io.ReadAll(c.Request.Body)
+	return data, "", err
+}
+
+// isFixedMPS indica si el parámetro ?format pide MPS fijo (por defecto MPS libre)
+func isFixedMPS(c *gin.Context) (bool, error) {
+	switch c.DefaultQuery("format", "free") {
+	case "free":
+		return false, nil
+	case "fixed":
+		return true, nil
+	}
+	return false, paramError("format", i18n.InvalidMPSFormat)
+}
+
+// SolveMPSHandler convierte un archivo MPS (fijo o libre, según ?format) en
+// SimplexRequest y lo resuelve.
+func SolveMPSHandler(c *gin.Context) {
+	fixed, err := isFixedMPS(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err, headerLanguage(c))
+		return
+	}
+	data, _, err := readModelFile(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err, headerLanguage(c))
+		return
+	}
+
+	model, err := formats.ParseMPS(bytes.NewReader(data), fixed)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err, headerLanguage(c))
+		return
+	}
+	solveParsedModel(c, model)
+}
+
+// ExportMPSHandler devuelve el SimplexRequest recibido como archivo MPS.
+// Parámetros: ?format=free|fixed y ?name= (nombre del modelo, por defecto SIMPLEX).
+func ExportMPSHandler(c *gin.Context) {
+	req, ok := bindModel(c)
+	if !ok {
+		return
+	}
+	fixed, err := isFixedMPS(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err, req.Language)
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := formats.WriteMPS(&buf, req, c.DefaultQuery("name", "SIMPLEX"), fixed); err != nil {
+		respondError(c, http.StatusBadRequest, err, req.Language)
+		return
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="model.mps"`)
+	c.Data(http.StatusOK, "text/plain; charset=utf-8", buf.Bytes())
+}
+
+// SolveLPHandler convierte un archivo en formato CPLEX LP en SimplexRequest y lo resuelve.
+func SolveLPHandler(c *gin.Context) {
+	data, _, err := readModelFile(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err, headerLanguage(c))
+		return
+	}
+
+	model, err := formats.ParseLP(string(data))
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err, headerLanguage(c))
+		return
+	}
+	solveParsedModel(c, model)
+}
+
+// ExportLPHandler devuelve el SimplexRequest recibido como archivo CPLEX LP.
+// Parámetro: ?name= (nombre del modelo, por defecto SIMPLEX).
+func ExportLPHandler(c *gin.Context) {
+	req, ok := bindModel(c)
+	if !ok {
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := formats.WriteLP(&buf, req, c.DefaultQuery("name", "SIMPLEX")); err != nil {
+		respondError(c, http.StatusBadRequest, err, req.Language)
+		return
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="model.lp"`)
+	c.Data(http.StatusOK, "text/plain; charset=utf-8", buf.Bytes())
+}
+
+// SolveSpreadsheetHandler convierte una planilla CSV o XLSX (ver formats.ParseSpreadsheet)
+// en SimplexRequest y la resuelve. El formato se toma de ?format=csv|xlsx o de la
+// extensión del archivo; ?sheet= elige la hoja del XLSX.
+func SolveSpreadsheetHandler(c *gin.Context) {
+	data, filename, err := readModelFile(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err, headerLanguage(c))
+		return
+	}
+
+	format := c.Query("format")
+	if format == "" {
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(filename)), ".")
+	}
+
+	var rows [][]string
+	switch format {
+	case "csv":
+		rows, err = formats.ReadCSV(bytes.NewReader(data))
+	case "xlsx":
+		rows, err = formats.ReadXLSX(bytes.NewReader(data), c.Query("sheet"))
+	default:
+		err = paramError("format", i18n.InvalidSheetFormat)
+	}
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err, headerLanguage(c))
+		return
+	}
+
+	model, err := formats.ParseSpreadsheet(rows)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err, headerLanguage(c))
+		return
+	}
+	solveParsedModel(c, model)
+}
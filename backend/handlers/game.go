@@ -0,0 +1,29 @@
+package handlers
+
+import (
+	"net/http"
+
+	"proyecto/simplex/i18n"
+	"proyecto/simplex/logic"
+	"proyecto/simplex/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GameHandler resuelve un juego de suma cero de dos jugadores y devuelve el valor
+// del juego con las estrategias óptimas de cada jugador
+func GameHandler(c *gin.Context) {
+	var req models.GameRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, http.StatusBadRequest, i18n.New(i18n.InvalidJSON, err.Error()), headerLanguage(c))
+		return
+	}
+	lang := fillLanguage(c, &req.Language)
+
+	response, err := logic.SolveGame(req)
+	if err != nil {
+		respondModelError(c, err, lang)
+		return
+	}
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,29 @@
+package handlers
+
+import (
+	"net/http"
+
+	"proyecto/simplex/i18n"
+	"proyecto/simplex/logic"
+	"proyecto/simplex/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GoalHandler resuelve un modelo de programación por metas y devuelve cada
+// programa lineal resuelto y el cumplimiento de cada meta
+func GoalHandler(c *gin.Context) {
+	var req models.GoalRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, http.StatusBadRequest, i18n.New(i18n.InvalidJSON, err.Error()), headerLanguage(c))
+		return
+	}
+	lang := fillLanguage(c, &req.Language)
+
+	response, err := logic.SolveGoals(req)
+	if err != nil {
+		respondModelError(c, err, lang)
+		return
+	}
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,29 @@
+package handlers
+
+import (
+	"net/http"
+
+	"proyecto/simplex/i18n"
+	"proyecto/simplex/logic"
+	"proyecto/simplex/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GradeHandler corrige las tablas de un alumno: recibe el modelo (mismos campos que
+// /api/simplex) más "tableaux" y responde el informe por tabla con el primer error
+func GradeHandler(c *gin.Context) {
+	var req models.GradeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, http.StatusBadRequest, i18n.New(i18n.InvalidJSON, err.Error()), headerLanguage(c))
+		return
+	}
+	lang := resolveLanguage(c, &req.SimplexRequest)
+
+	response, err := logic.GradeTableaux(req)
+	if err != nil {
+		respondModelError(c, err, lang)
+		return
+	}
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"bytes"
+	"net/http"
+
+	"proyecto/simplex/i18n"
+	"proyecto/simplex/logic"
+	"proyecto/simplex/report"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GraphHandler devuelve el método gráfico del modelo (mismo cuerpo que /api/simplex,
+// de 2 o 3 variables): vértices, caras, óptimo y recorrido del Simplex como JSON. Con
+// ?format=svg devuelve el gráfico de un modelo de 2 variables como imagen SVG.
+func GraphHandler(c *gin.Context) {
+	req, ok := bindModel(c)
+	if !ok {
+		return
+	}
+
+	region, err := logic.FeasibleRegion(req)
+	if err != nil {
+		respondModelError(c, err, req.Language)
+		return
+	}
+	if c.Query("format") != "svg" {
+		c.JSON(http.StatusOK, region)
+		return
+	}
+
+	if region.Dimension != 2 {
+		respondError(c, http.StatusUnprocessableEntity, i18n.New(i18n.GraphSVGDimensions), req.Language)
+		return
+	}
+	var buf bytes.Buffer
+	if err := report.WriteSVG(&buf, region); err != nil {
+		respondError(c, http.StatusInternalServerError, err, req.Language)
+		return
+	}
+	c.Data(http.StatusOK, "image/svg+xml", buf.Bytes())
+}
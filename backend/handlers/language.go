@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"net/http"
+
+	"proyecto/simplex/i18n"
+	"proyecto/simplex/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// headerLanguage devuelve el idioma pedido en el encabezado Accept-Language
+func headerLanguage(c *gin.Context) string {
+	return i18n.FromAcceptLanguage(c.GetHeader("Accept-Language"))
+}
+
+// resolveLanguage completa req.Language con el encabezado Accept-Language cuando el
+// modelo no lo indica y devuelve el idioma que se usará en la respuesta
+func resolveLanguage(c *gin.Context, req *models.SimplexRequest) string {
+	return fillLanguage(c, &req.Language)
+}
+
+// fillLanguage completa el idioma de cualquier cuerpo con Accept-Language si falta
+func fillLanguage(c *gin.Context, lang *string) string {
+	if *lang == "" {
+		*lang = headerLanguage(c)
+	}
+	return i18n.Normalize(*lang)
+}
+
+// bindModel lee un SimplexRequest del cuerpo JSON. Si falla responde 400 y devuelve ok=false.
+func bindModel(c *gin.Context) (models.SimplexRequest, bool) {
+	var req models.SimplexRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, http.StatusBadRequest, i18n.New(i18n.InvalidJSON, err.Error()), headerLanguage(c))
+		return req, false
+	}
+	resolveLanguage(c, &req)
+	return req, true
+}
@@ -0,0 +1,29 @@
+package handlers
+
+import (
+	"net/http"
+
+	"proyecto/simplex/i18n"
+	"proyecto/simplex/logic"
+	"proyecto/simplex/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// NetworkHandler resuelve un problema de redes con el simplex de redes y devuelve
+// los cambios del árbol generador en cada iteración
+func NetworkHandler(c *gin.Context) {
+	var req models.NetworkRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, http.StatusBadRequest, i18n.New(i18n.InvalidJSON, err.Error()), headerLanguage(c))
+		return
+	}
+	lang := fillLanguage(c, &req.Language)
+
+	response, err := logic.SolveNetwork(req)
+	if err != nil {
+		respondModelError(c, err, lang)
+		return
+	}
+	c.JSON(http.StatusOK, response)
+}
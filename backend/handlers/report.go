@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"bytes"
+	"net/http"
+
+	"proyecto/simplex/logic"
+	"proyecto/simplex/models"
+	"proyecto/simplex/report"
+
+	"github.com/gin-gonic/gin"
+)
+
+// bindAndSolve lee el SimplexRequest del cuerpo y lo resuelve. Si falla responde
+// el error (400 o 422) y devuelve ok=false.
+func bindAndSolve(c *gin.Context) (models.SimplexRequest, models.SimplexResponse, bool) {
+	req, ok := bindModel(c)
+	if !ok {
+		return req, models.SimplexResponse{}, false
+	}
+
+	result, err := logic.SolveRequest(req)
+	if err != nil {
+		respondModelError(c, err, req.Language)
+		return req, models.SimplexResponse{}, false
+	}
+	return req, result, true
+}
+
+// LaTeXHandler resuelve el modelo y devuelve el historial de tablas como documento
+// LaTeX (.tex). Con ?fractions=true los valores se muestran como fracciones.
+func LaTeXHandler(c *gin.Context) {
+	req, result, ok := bindAndSolve(c)
+	if !ok {
+		return
+	}
+
+	var buf bytes.Buffer
+	opts := report.LaTeXOptions{Fractions: c.Query("fractions") == "true"}
+	if err := report.WriteLaTeX(&buf, req, result, opts); err != nil {
+		respondError(c, http.StatusInternalServerError, err, req.Language)
+		return
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="simplex.tex"`)
+	c.Data(http.StatusOK, "application/x-tex; charset=utf-8", buf.Bytes())
+}
+
+// ReportHandler resuelve el modelo (mismo cuerpo que /api/simplex) y devuelve un
+// reporte PDF con el enunciado, el método usado, las tablas y la solución.
+func ReportHandler(c *gin.Context) {
+	req, result, ok := bindAndSolve(c)
+	if !ok {
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := report.WritePDF(&buf, req, result); err != nil {
+		respondError(c, http.StatusInternalServerError, err, req.Language)
+		return
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="simplex.pdf"`)
+	c.Data(http.StatusOK, "application/pdf", buf.Bytes())
+}
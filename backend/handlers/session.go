@@ -0,0 +1,175 @@
+package handlers
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"sync"
+	"time"
+
+	"proyecto/simplex/i18n"
+	"proyecto/simplex/logic"
+	"proyecto/simplex/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// sessionTTL es el tiempo sin uso tras el cual se descarta una sesión
+const sessionTTL = 2 * time.Hour
+
+// MaxSessions es la cantidad máxima de sesiones en memoria: al crear una más se
+// descarta la que lleva más tiempo sin usarse
+const MaxSessions = 1000
+
+// sessionStore guarda en memoria las sesiones del modo interactivo. Un único mutex
+// serializa las operaciones: cada una es un pivoteo sobre una tabla chica.
+type sessionStore struct {
+	mu       sync.Mutex
+	sessions map[string]*storedSession
+}
+
+type storedSession struct {
+	session  *logic.PivotSession
+	lastUsed time.Time
+}
+
+var sessions = &sessionStore{sessions: make(map[string]*storedSession)}
+
+// add guarda la sesión con un identificador aleatorio, descarta las vencidas y, si
+// se llegó a MaxSessions, la usada hace más tiempo
+func (s *sessionStore) add(session *logic.PivotSession) (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	id := hex.EncodeToString(buf)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	oldest := ""
+	for key, stored := range s.sessions {
+		switch {
+		case now.Sub(stored.lastUsed) > sessionTTL:
+			delete(s.sessions, key)
+		case oldest == "" || stored.lastUsed.Before(s.sessions[oldest].lastUsed):
+			oldest = key
+		}
+	}
+	if len(s.sessions) >= MaxSessions {
+		delete(s.sessions, oldest)
+	}
+	s.sessions[id] = &storedSession{session: session, lastUsed: now}
+	return id, nil
+}
+
+// with ejecuta fn sobre la sesión con el lock tomado; devuelve false si no existe
+func (s *sessionStore) with(id string, fn func(*logic.PivotSession)) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored, ok := s.sessions[id]
+	if !ok || time.Since(stored.lastUsed) > sessionTTL {
+		delete(s.sessions, id)
+		return false
+	}
+	stored.lastUsed = time.Now()
+	fn(stored.session)
+	return true
+}
+
+func (s *sessionStore) remove(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.sessions[id]
+	delete(s.sessions, id)
+	return ok
+}
+
+// pivotRequest es el pivote elegido: fila y columna de la tabla actual
+type pivotRequest struct {
+	Row int `json:"row"`
+	Col int `json:"col"`
+}
+
+// CreateSessionHandler crea una sesión del modo interactivo a partir del modelo
+// (mismo cuerpo que /api/simplex) y responde 201 con la tabla inicial
+func CreateSessionHandler(c *gin.Context) {
+	req, ok := bindModel(c)
+	if !ok {
+		return
+	}
+
+	session, err := logic.NewPivotSession(req)
+	if err != nil {
+		respondModelError(c, err, req.Language)
+		return
+	}
+	id, err := sessions.add(session)
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, err, req.Language)
+		return
+	}
+
+	response := session.State()
+	response.ID = id
+	c.JSON(http.StatusCreated, response)
+}
+
+// GetSessionHandler devuelve la tabla actual de la sesión
+func GetSessionHandler(c *gin.Context) {
+	withSession(c, func(session *logic.PivotSession) (models.SessionResponse, error) {
+		return session.State(), nil
+	})
+}
+
+// PivotSessionHandler evalúa el pivote elegido y, si es válido, lo aplica. Los
+// pivotes inválidos también responden 200, con feedback.valid = false.
+func PivotSessionHandler(c *gin.Context) {
+	var req pivotRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, http.StatusBadRequest, i18n.New(i18n.InvalidJSON, err.Error()), headerLanguage(c))
+		return
+	}
+	withSession(c, func(session *logic.PivotSession) (models.SessionResponse, error) {
+		return session.Pivot(req.Row, req.Col), nil
+	})
+}
+
+// UndoSessionHandler deshace el último pivote (409 si no hay ninguno)
+func UndoSessionHandler(c *gin.Context) {
+	withSession(c, func(session *logic.PivotSession) (models.SessionResponse, error) {
+		if err := session.Undo(); err != nil {
+			return models.SessionResponse{}, err
+		}
+		return session.State(), nil
+	})
+}
+
+// DeleteSessionHandler descarta la sesión
+func DeleteSessionHandler(c *gin.Context) {
+	if !sessions.remove(c.Param("id")) {
+		respondError(c, http.StatusNotFound, i18n.New(i18n.SessionNotFound, c.Param("id")), headerLanguage(c))
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// withSession ejecuta la operación sobre la sesión de la URL y responde su estado
+func withSession(c *gin.Context, fn func(*logic.PivotSession) (models.SessionResponse, error)) {
+	id := c.Param("id")
+	var response models.SessionResponse
+	var err error
+	found := sessions.with(id, func(session *logic.PivotSession) {
+		response, err = fn(session)
+	})
+
+	switch {
+	case !found:
+		respondError(c, http.StatusNotFound, i18n.New(i18n.SessionNotFound, id), headerLanguage(c))
+	case err != nil:
+		respondError(c, http.StatusConflict, err, headerLanguage(c))
+	default:
+		response.ID = id
+		c.JSON(http.StatusOK, response)
+	}
+}
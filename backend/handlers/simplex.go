@@ -1,38 +1,95 @@
 package handlers
 
 import (
+	"bytes"
 	"net/http"
 
+	"proyecto/simplex/i18n"
 	"proyecto/simplex/logic"
-
 	"proyecto/simplex/models"
+	"proyecto/simplex/report"
 
 	"github.com/gin-gonic/gin"
 )
 
+// SolveSimplexHandler resuelve el modelo recibido. Con ?format=xlsx devuelve un
+// libro con la solución y una hoja por iteración, y con ?format=csv un ZIP de CSVs;
+// sin el parámetro (o con format=json) responde JSON.
 func SolveSimplexHandler(c *gin.Context) {
-	var req models.SimplexRequest
-
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	req, ok := bindModel(c)
+	if !ok {
 		return
 	}
 
-	// Validar type
-	if req.Type != "max" && req.Type != "min" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "El campo 'type' debe ser 'max' o 'min'"})
+	format := c.DefaultQuery("format", "json")
+	if format != "json" && format != "xlsx" && format != "csv" {
+		respondError(c, http.StatusBadRequest, paramError("format", i18n.InvalidFormat), req.Language)
 		return
 	}
 
-	var result models.SimplexResponse
+	result, err := logic.SolveRequest(req)
+	if err != nil {
+		respondModelError(c, err, req.Language)
+		return
+	}
 
-	if req.Type == "min" {
-		result = logic.SolveSimplexMinWithTypes(req.Objective, req.Constraints, req.RHS, req.ConstraintTypes)
-	} else {
-		result = logic.SolveSimplexMaxWithTypes(req.Objective, req.Constraints, req.RHS, req.ConstraintTypes)
+	var buf bytes.Buffer
+	switch format {
+	case "xlsx":
+		if err := report.WriteXLSX(&buf, req, result); err != nil {
+			respondError(c, http.StatusInternalServerError, err, req.Language)
+			return
+		}
+		c.Header("Content-Disposition", `attachment; filename="simplex.xlsx"`)
+		c.Data(http.StatusOK, "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", buf.Bytes())
+		return
+	case "csv":
+		if err := report.WriteCSVZip(&buf, req, result); err != nil {
+			respondError(c, http.StatusInternalServerError, err, req.Language)
+			return
+		}
+		c.Header("Content-Disposition", `attachment; filename="simplex.zip"`)
+		c.Data(http.StatusOK, "application/zip", buf.Bytes())
+		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"result": result,
 	})
 }
+
+// SolveSimplexV2Handler resuelve el modelo (mismo cuerpo que /api/simplex) y responde
+// con SimplexResponseV2: estado tipado, banderas de degeneración y óptimos alternativos
+// y un objeto "error". Los modelos inválidos responden 400 (o 422 si solo usan
+// características no soportadas) con el mismo formato y status "error".
+func SolveSimplexV2Handler(c *gin.Context) {
+	var req models.SimplexRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondV2Error(c, http.StatusBadRequest, i18n.New(i18n.InvalidJSON, err.Error()), headerLanguage(c))
+		return
+	}
+	lang := resolveLanguage(c, &req)
+
+	result, err := logic.SolveRequest(req)
+	if err != nil {
+		respondV2Error(c, modelErrorStatus(err), err, lang)
+		return
+	}
+
+	v2 := result.V2()
+	if result.Err != nil {
+		apiErr := apiError(result.Err, http.StatusOK, lang)
+		v2.Error = &apiErr
+	}
+	c.JSON(http.StatusOK, v2)
+}
+
+func respondV2Error(c *gin.Context, status int, err error, lang string) {
+	apiErr := apiError(err, status, lang)
+	c.JSON(status, models.SimplexResponseV2{
+		Version: models.ResponseVersion2,
+		Status:  models.StatusError,
+		Message: apiErr.Message,
+		Error:   &apiErr,
+	})
+}
@@ -0,0 +1,29 @@
+package handlers
+
+import (
+	"net/http"
+
+	"proyecto/simplex/i18n"
+	"proyecto/simplex/logic"
+	"proyecto/simplex/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TransportationHandler resuelve un problema de transporte: oferta, demanda y matriz
+// de costos, con la solución inicial elegida en "method" y cada tabla de MODI
+func TransportationHandler(c *gin.Context) {
+	var req models.TransportationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, http.StatusBadRequest, i18n.New(i18n.InvalidJSON, err.Error()), headerLanguage(c))
+		return
+	}
+	lang := fillLanguage(c, &req.Language)
+
+	response, err := logic.SolveTransportation(req)
+	if err != nil {
+		respondModelError(c, err, lang)
+		return
+	}
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,29 @@
+package handlers
+
+import (
+	"net/http"
+
+	"proyecto/simplex/i18n"
+	"proyecto/simplex/logic"
+	"proyecto/simplex/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// VerifyHandler verifica una solución calculada fuera del solver: recibe el modelo
+// (mismos campos que /api/simplex) más "solution" y, opcionalmente, "duals".
+func VerifyHandler(c *gin.Context) {
+	var req models.VerifyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, http.StatusBadRequest, i18n.New(i18n.InvalidJSON, err.Error()), headerLanguage(c))
+		return
+	}
+	lang := resolveLanguage(c, &req.SimplexRequest)
+
+	response, err := logic.VerifySolution(req)
+	if err != nil {
+		respondModelError(c, err, lang)
+		return
+	}
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,25 @@
+package handlers
+
+import (
+	"net/http"
+
+	"proyecto/simplex/logic"
+
+	"github.com/gin-gonic/gin"
+)
+
+// VerticesHandler enumera los vértices del modelo (mismo cuerpo que /api/simplex)
+// probando todas las bases, con el vértice de cada tabla del historial del solver
+func VerticesHandler(c *gin.Context) {
+	req, ok := bindModel(c)
+	if !ok {
+		return
+	}
+
+	response, err := logic.EnumerateVertices(req)
+	if err != nil {
+		respondModelError(c, err, req.Language)
+		return
+	}
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,735 @@
+package i18n
+
+// Códigos de validación del modelo
+const (
+	EmptyObjective       Code = "empty_objective"
+	EmptyConstraints     Code = "empty_constraints"
+	EmptyRHS             Code = "empty_rhs"
+	RaggedConstraints    Code = "ragged_constraints"
+	RHSLength            Code = "rhs_length"
+	ObjectiveLength      Code = "objective_length"
+	NonFiniteObjective   Code = "non_finite_objective"
+	NonFiniteConstraints Code = "non_finite_constraints"
+	NonFiniteRHS         Code = "non_finite_rhs"
+	InvalidType          Code = "invalid_type"
+	ConstraintSizes      Code = "constraint_sizes"
+	EqualityUnsupported  Code = "equality_unsupported"
+	UnknownConstraint    Code = "unknown_constraint_type"
+	BoundsLength         Code = "bounds_length"
+	NegativeLowerBound   Code = "negative_lower_bound"
+)
+
+// Códigos de control del solver (condiciones de parada del Simplex)
+const (
+	OptimalReached  Code = "optimal_reached"
+	FeasibleReached Code = "feasible_reached"
+	Unbounded       Code = "unbounded"
+	Infeasible      Code = "infeasible"
+)
+
+// Descripción de cada estado de la respuesta
+const (
+	StatusOptimal        Code = "status_optimal"
+	StatusMultiple       Code = "status_multiple"
+	StatusUnbounded      Code = "status_unbounded"
+	StatusInfeasible     Code = "status_infeasible"
+	StatusIterationLimit Code = "status_iteration_limit"
+	StatusDegenerate     Code = "status_degenerate"
+)
+
+// Errores de los parámetros de la API
+const (
+	InvalidJSON        Code = "invalid_json"
+	InvalidFormat      Code = "invalid_format"
+	InvalidMPSFormat   Code = "invalid_mps_format"
+	InvalidSheetFormat Code = "invalid_sheet_format"
+	InvalidModel       Code = "invalid_model"
+	UnsupportedModel   Code = "unsupported_model"
+)
+
+// Explicaciones de las iteraciones (explain: true)
+const (
+	ExplainPrimalEntering  Code = "explain_primal_entering"
+	ExplainPrimalRatios    Code = "explain_primal_ratios"
+	ExplainPrimalUnbounded Code = "explain_primal_unbounded"
+	ExplainPrimalOptimal   Code = "explain_primal_optimal"
+	ExplainDualLeaving     Code = "explain_dual_leaving"
+	ExplainDualRatios      Code = "explain_dual_ratios"
+	ExplainDualInfeasible  Code = "explain_dual_infeasible"
+	ExplainDualOptimal     Code = "explain_dual_optimal"
+	ExplainPivot           Code = "explain_pivot"
+	ExplainDegenerate      Code = "explain_degenerate"
+)
+
+// Descripción de la cara óptima (alternatives: true)
+const (
+	OptimalFaceUnique   Code = "optimal_face_unique"
+	OptimalFaceVertices Code = "optimal_face_vertices"
+	OptimalFaceRays     Code = "optimal_face_rays"
+	OptimalFaceRayOnly  Code = "optimal_face_ray_only"
+)
+
+// Verificación de la dualidad (/api/simplex/dual?solve=true)
+const (
+	DualityHolds               Code = "duality_holds"
+	DualityViolated            Code = "duality_violated"
+	DualityBothInfeasible      Code = "duality_both_infeasible"
+	DualityUnboundedInfeasible Code = "duality_unbounded_infeasible"
+	DualityStatusMismatch      Code = "duality_status_mismatch"
+	DualityStrongViolated      Code = "duality_strong_violated"
+	DualitySlackRow            Code = "duality_slack_row"
+	DualitySlackVariable       Code = "duality_slack_variable"
+)
+
+// Verificación de soluciones externas (/api/simplex/verify)
+const (
+	UnknownVariable           Code = "unknown_variable"
+	UnknownDual               Code = "unknown_dual"
+	NonFiniteSolution         Code = "non_finite_solution"
+	VerifyInfeasible          Code = "verify_infeasible"
+	VerifyOptimal             Code = "verify_optimal"
+	VerifyNotOptimal          Code = "verify_not_optimal"
+	VerifyUnbounded           Code = "verify_unbounded"
+	VerifyUnknown             Code = "verify_unknown"
+	VerifyDualsNotCertificate Code = "verify_duals_not_certificate"
+)
+
+// Modo interactivo (sesiones de pivoteo)
+const (
+	SessionNotFound          Code = "session_not_found"
+	SessionNothingToUndo     Code = "session_nothing_to_undo"
+	SessionInProgress        Code = "session_in_progress"
+	SessionFinished          Code = "session_finished"
+	SessionPivotOutOfRange   Code = "session_pivot_out_of_range"
+	SessionPivotBasic        Code = "session_pivot_basic"
+	SessionPivotNotImproving Code = "session_pivot_not_improving"
+	SessionPivotNonPositive  Code = "session_pivot_non_positive"
+	SessionPivotNotMinRatio  Code = "session_pivot_not_min_ratio"
+	SessionDualRowFeasible   Code = "session_dual_row_feasible"
+	SessionDualNonNegative   Code = "session_dual_non_negative"
+	SessionDualNotMinRatio   Code = "session_dual_not_min_ratio"
+	SessionPivotMatches      Code = "session_pivot_matches"
+	SessionPivotDiffers      Code = "session_pivot_differs"
+)
+
+// Corrección de tablas de alumnos (/api/simplex/grade)
+const (
+	GradeNoTableaux       Code = "grade_no_tableaux"
+	GradeDimensions       Code = "grade_dimensions"
+	GradePreviousInvalid  Code = "grade_previous_invalid"
+	GradePivotUnknown     Code = "grade_pivot_unknown"
+	GradeStepCorrect      Code = "grade_step_correct"
+	GradeInitialWrong     Code = "grade_initial_wrong"
+	GradeCellsWrong       Code = "grade_cells_wrong"
+	GradeRuleDiffers      Code = "grade_rule_differs"
+	GradeFinalInvalid     Code = "grade_final_invalid"
+	GradeFinalNotFinal    Code = "grade_final_not_final"
+	GradeFinalWrongStatus Code = "grade_final_wrong_status"
+	GradeFinalWrongValue  Code = "grade_final_wrong_value"
+	GradePassed           Code = "grade_passed"
+	GradeFailed           Code = "grade_failed"
+	GradeStepsCorrect     Code = "grade_steps_correct"
+)
+
+// Método gráfico (/api/simplex/graph)
+const (
+	GraphDimensions    Code = "graph_dimensions"
+	GraphTooLarge      Code = "graph_too_large"
+	GraphSVGDimensions Code = "graph_svg_dimensions"
+)
+
+// Enumeración de vértices (/api/simplex/vertices)
+const (
+	VerticesTooMany Code = "vertices_too_many"
+	VerticesFound   Code = "vertices_found"
+)
+
+// Problema de transporte (/api/transportation)
+const (
+	TransportEmptySupply           Code = "transport_empty_supply"
+	TransportEmptyDemand           Code = "transport_empty_demand"
+	TransportInvalidAmount         Code = "transport_invalid_amount"
+	TransportCostRows              Code = "transport_cost_rows"
+	TransportCostColumns           Code = "transport_cost_columns"
+	TransportNonFiniteCost         Code = "transport_non_finite_cost"
+	TransportUnknownMethod         Code = "transport_unknown_method"
+	TransportDummySource           Code = "transport_dummy_source"
+	TransportDummyDestination      Code = "transport_dummy_destination"
+	TransportDummySourceAdded      Code = "transport_dummy_source_added"
+	TransportDummyDestinationAdded Code = "transport_dummy_destination_added"
+	TransportOptimal               Code = "transport_optimal"
+)
+
+// Problema de asignación (/api/assignment)
+const (
+	AssignmentEmptyCosts      Code = "assignment_empty_costs"
+	AssignmentRaggedCosts     Code = "assignment_ragged_costs"
+	AssignmentNonFiniteCost   Code = "assignment_non_finite_cost"
+	AssignmentForbiddenRange  Code = "assignment_forbidden_range"
+	AssignmentDummyRow        Code = "assignment_dummy_row"
+	AssignmentDummyColumn     Code = "assignment_dummy_column"
+	AssignmentRowReduction    Code = "assignment_row_reduction"
+	AssignmentColumnReduction Code = "assignment_column_reduction"
+	AssignmentCover           Code = "assignment_cover"
+	AssignmentAdjust          Code = "assignment_adjust"
+	AssignmentInfeasible      Code = "assignment_infeasible"
+	AssignmentOptimalMin      Code = "assignment_optimal_min"
+	AssignmentOptimalMax      Code = "assignment_optimal_max"
+)
+
+// Problemas de redes (/api/network)
+const (
+	NetworkEmptyNodes          Code = "network_empty_nodes"
+	NetworkNodeName            Code = "network_node_name"
+	NetworkUnknownNode         Code = "network_unknown_node"
+	NetworkSelfLoop            Code = "network_self_loop"
+	NetworkNonFinite           Code = "network_non_finite"
+	NetworkInvalidBounds       Code = "network_invalid_bounds"
+	NetworkUnbalanced          Code = "network_unbalanced"
+	NetworkSourceSink          Code = "network_source_sink"
+	NetworkUnknownProblem      Code = "network_unknown_problem"
+	NetworkRoot                Code = "network_root"
+	NetworkMinCostOptimal      Code = "network_min_cost_optimal"
+	NetworkMaxFlowOptimal      Code = "network_max_flow_optimal"
+	NetworkShortestPathOptimal Code = "network_shortest_path_optimal"
+	NetworkInfiniteFlow        Code = "network_infinite_flow"
+	NetworkNegativeCycle       Code = "network_negative_cycle"
+	NetworkNoPath              Code = "network_no_path"
+)
+
+// Juegos de suma cero (/api/game)
+const (
+	GameEmptyPayoffs    Code = "game_empty_payoffs"
+	GameRaggedPayoffs   Code = "game_ragged_payoffs"
+	GameNonFinitePayoff Code = "game_non_finite_payoff"
+	GameRowDominated    Code = "game_row_dominated"
+	GameColumnDominated Code = "game_column_dominated"
+	GameSaddlePoint     Code = "game_saddle_point"
+	GameMixed           Code = "game_mixed"
+)
+
+// Programación por metas (/api/goal)
+const (
+	GoalEmpty         Code = "goal_empty"
+	GoalColumns       Code = "goal_columns"
+	GoalNonFinite     Code = "goal_non_finite"
+	GoalType          Code = "goal_type"
+	GoalWeight        Code = "goal_weight"
+	GoalPriority      Code = "goal_priority"
+	GoalUnknownMethod Code = "goal_unknown_method"
+	GoalSummary       Code = "goal_summary"
+)
+
+// Lectura y escritura de archivos de modelos (algebraico, LP, MPS y planillas)
+const (
+	SyntaxAt                  Code = "syntax_at"
+	TokenEOF                  Code = "token_eof"
+	TokenNewline              Code = "token_newline"
+	SyntaxUnexpectedChar      Code = "syntax_unexpected_char"
+	SyntaxInvalidNumber       Code = "syntax_invalid_number"
+	SyntaxAmbiguousNumber     Code = "syntax_ambiguous_number"
+	SyntaxExpectedEndOfLine   Code = "syntax_expected_end_of_line"
+	SyntaxExpectedAfter       Code = "syntax_expected_after"
+	SyntaxTrailingContent     Code = "syntax_trailing_content"
+	SyntaxMissingEnd          Code = "syntax_missing_end"
+	SyntaxNoConstraints       Code = "syntax_no_constraints"
+	SyntaxModelStart          Code = "syntax_model_start"
+	SyntaxEmptyObjective      Code = "syntax_empty_objective"
+	SyntaxObjectiveConstant   Code = "syntax_objective_constant"
+	SyntaxExpectedSubjectTo   Code = "syntax_expected_subject_to"
+	SyntaxExpectedRelation    Code = "syntax_expected_relation"
+	SyntaxExpectedLE          Code = "syntax_expected_le"
+	SyntaxExpectedBound       Code = "syntax_expected_bound"
+	SyntaxEmptyConstraint     Code = "syntax_empty_constraint"
+	SyntaxConstantLeft        Code = "syntax_constant_left"
+	SyntaxInfiniteRHS         Code = "syntax_infinite_rhs"
+	SyntaxDuplicateConstraint Code = "syntax_duplicate_constraint"
+	SyntaxExpectedNumber      Code = "syntax_expected_number"
+	SyntaxExpectedVariable    Code = "syntax_expected_variable"
+	SyntaxUnknownVariable     Code = "syntax_unknown_variable"
+	SyntaxIntegerEmpty        Code = "syntax_integer_empty"
+	SyntaxExpectedFactor      Code = "syntax_expected_factor"
+	SyntaxExpectedTerm        Code = "syntax_expected_term"
+	MPSAt                     Code = "mps_at"
+	MPSUnknownSection         Code = "mps_unknown_section"
+	MPSOutsideSection         Code = "mps_outside_section"
+	MPSMissingEndata          Code = "mps_missing_endata"
+	MPSNoObjective            Code = "mps_no_objective"
+	MPSObjSense               Code = "mps_objsense"
+	MPSRowFields              Code = "mps_row_fields"
+	MPSDuplicateRow           Code = "mps_duplicate_row"
+	MPSRowType                Code = "mps_row_type"
+	MPSMarker                 Code = "mps_marker"
+	MPSColumnFields           Code = "mps_column_fields"
+	MPSUnknownRow             Code = "mps_unknown_row"
+	MPSValueFields            Code = "mps_value_fields"
+	MPSBoundFields            Code = "mps_bound_fields"
+	MPSBoundFieldCount        Code = "mps_bound_field_count"
+	MPSUnknownColumn          Code = "mps_unknown_column"
+	MPSBoundType              Code = "mps_bound_type"
+	MPSInvalidNumber          Code = "mps_invalid_number"
+	WriteInvalidName          Code = "write_invalid_name"
+	WriteNameTooLong          Code = "write_name_too_long"
+	SheetRow                  Code = "sheet_row"
+	SheetCell                 Code = "sheet_cell"
+	SheetTooShort             Code = "sheet_too_short"
+	SheetType                 Code = "sheet_type"
+	SheetHeader               Code = "sheet_header"
+	SheetNotNumeric           Code = "sheet_not_numeric"
+	SheetConstraintType       Code = "sheet_constraint_type"
+)
+
+var catalog = map[string]map[Code]string{
+	Spanish: {
+		EmptyObjective:       "vector objective no puede estar vacío",
+		EmptyConstraints:     "matriz constraints no puede estar vacía",
+		EmptyRHS:             "vector rhs no puede estar vacío",
+		RaggedConstraints:    "todas las filas de constraints deben tener el mismo número de columnas",
+		RHSLength:            "la longitud de rhs no coincide con el número de filas de constraints",
+		ObjectiveLength:      "la longitud de objective no coincide con el número de columnas de constraints",
+		NonFiniteObjective:   "objective contiene valores no finitos",
+		NonFiniteConstraints: "constraints contiene valores no finitos",
+		NonFiniteRHS:         "rhs contiene valores no finitos",
+		InvalidType:          "El campo 'type' debe ser 'max' o 'min'",
+		ConstraintSizes:      "los tamaños de las restricciones, RHS y tipos no coinciden",
+		EqualityUnsupported:  "solver simple no soporta restricciones de igualdad (=). Se necesita el método de Gran M",
+		UnknownConstraint:    "tipo de restricción no reconocido: %s",
+		BoundsLength:         "la longitud de bounds no coincide con el número de variables",
+		NegativeLowerBound:   "la variable %s tiene cota inferior negativa o es libre: el solver solo admite variables no negativas",
+
+		OptimalReached:  "solución óptima encontrada",
+		FeasibleReached: "solución factible encontrada",
+		Unbounded:       "problema ilimitado (unbounded)",
+		Infeasible:      "problema infactible (infeasible)",
+
+		StatusOptimal:        "Se encontró la solución óptima",
+		StatusMultiple:       "La función objetivo es constante: todas las soluciones factibles son óptimas",
+		StatusUnbounded:      "El problema es ilimitado: la función objetivo puede mejorar indefinidamente",
+		StatusInfeasible:     "El problema no tiene soluciones factibles",
+		StatusIterationLimit: "Se alcanzó el límite de iteraciones sin llegar al óptimo",
+		StatusDegenerate:     "La base óptima es degenerada (una variable básica vale 0): los rangos de sensibilidad pueden ser unilaterales",
+
+		InvalidJSON:        "JSON inválido: %s",
+		InvalidFormat:      "El parámetro 'format' debe ser 'json', 'xlsx' o 'csv'",
+		InvalidMPSFormat:   "El parámetro 'format' debe ser 'free' o 'fixed'",
+		InvalidSheetFormat: "El parámetro 'format' debe ser 'csv' o 'xlsx'",
+		InvalidModel:       "El modelo tiene %d errores",
+		UnsupportedModel:   "El modelo usa %d características que el solver no soporta",
+
+		ExplainPrimalEntering:  "Entra %s porque tiene el coeficiente más negativo (%s) en la fila Z.",
+		ExplainPrimalRatios:    "Prueba del cociente: %s, así que sale %s (menor cociente %s).",
+		ExplainPrimalUnbounded: "Ninguna fila tiene coeficiente positivo en la columna de %s: el problema es ilimitado.",
+		ExplainPrimalOptimal:   "Ningún coeficiente de la fila Z es negativo: la tabla es óptima (Z = %s).",
+		ExplainDualLeaving:     "Sale %s porque tiene el término independiente más negativo (%s).",
+		ExplainDualRatios:      "Cocientes |Z / fila pivote| de los coeficientes negativos: %s, así que entra %s (menor cociente %s).",
+		ExplainDualInfeasible:  "La fila de %s no tiene coeficientes negativos: el problema es infactible.",
+		ExplainDualOptimal:     "Todos los términos independientes son no negativos: la tabla es factible y óptima (Z = %s).",
+		ExplainPivot:           "Elemento pivote: %s.",
+		ExplainDegenerate:      "El pivote es degenerado: el cociente mínimo es 0, así que cambia la base pero no la solución ni el valor de Z.",
+
+		OptimalFaceUnique:   "La solución óptima es única.",
+		OptimalFaceVertices: "Toda combinación convexa de los %d vértices óptimos (x = Σ λk·vk con λk ≥ 0 y Σ λk = 1) es una solución óptima.",
+		OptimalFaceRays:     "También lo es al sumarle cualquier combinación no negativa de las %d direcciones (Σ μr·dr con μr ≥ 0).",
+		OptimalFaceRayOnly:  "Toda solución x = v1 + Σ μr·dr con μr ≥ 0, donde dr son las %d direcciones, es óptima.",
+
+		DualityHolds:               "Se cumplen la dualidad fuerte y la holgura complementaria.",
+		DualityViolated:            "Se encontraron %d violaciones de las condiciones de dualidad.",
+		DualityBothInfeasible:      "El primal y el dual son infactibles.",
+		DualityUnboundedInfeasible: "Un problema es ilimitado y el otro infactible, como predice la dualidad débil.",
+		DualityStatusMismatch:      "El primal es %s pero el dual es %s: deberían ser ambos óptimos, o uno ilimitado y el otro infactible.",
+		DualityStrongViolated:      "Los valores óptimos difieren: primal %s, dual %s.",
+		DualitySlackRow:            "La restricción %s tiene holgura %s pero su variable dual %s vale %s.",
+		DualitySlackVariable:       "La variable %s vale %s pero su restricción dual tiene holgura %s.",
+
+		UnknownVariable:           "la variable %s no existe en el modelo",
+		UnknownDual:               "la restricción %s no existe en el modelo",
+		NonFiniteSolution:         "la solución contiene valores no finitos",
+		VerifyInfeasible:          "La solución no es factible: %d restricciones no se cumplen.",
+		VerifyOptimal:             "La solución es factible y óptima (Z = %s).",
+		VerifyNotOptimal:          "La solución es factible pero no óptima: Z = %s y el óptimo es %s.",
+		VerifyUnbounded:           "La solución es factible (Z = %s) pero el problema es ilimitado: no tiene óptimo.",
+		VerifyUnknown:             "La solución es factible, pero no se pudo resolver el modelo para comprobar la optimalidad.",
+		VerifyDualsNotCertificate: "Los duales indicados no certifican la optimalidad: no son factibles o la brecha de dualidad no es 0.",
+
+		SessionNotFound:          "la sesión %s no existe o expiró",
+		SessionNothingToUndo:     "no hay pivotes para deshacer",
+		SessionInProgress:        "Elegí el próximo pivote (fila y columna de la tabla).",
+		SessionFinished:          "La tabla ya es final: no quedan pivotes por hacer.",
+		SessionPivotOutOfRange:   "La fila %d o la columna %d no son un pivote posible de la tabla.",
+		SessionPivotBasic:        "%s ya es básica: no puede entrar a la base.",
+		SessionPivotNotImproving: "%s no mejora la función objetivo: su coeficiente en la fila Z (%s) no es negativo.",
+		SessionPivotNonPositive:  "El elemento pivote (%s) debe ser positivo.",
+		SessionPivotNotMinRatio:  "La fila de %s no tiene el menor cociente (%s; el mínimo es %s): la solución dejaría de ser factible.",
+		SessionDualRowFeasible:   "La fila de %s tiene término independiente no negativo (%s): en el simplex dual sale una variable con valor negativo.",
+		SessionDualNonNegative:   "El elemento pivote (%s) debe ser negativo en el simplex dual.",
+		SessionDualNotMinRatio:   "La columna de %s no tiene el menor cociente (%s; el mínimo es %s): la tabla dejaría de ser óptima.",
+		SessionPivotMatches:      "Pivote correcto: entra %s y sale %s, como indica la regla del solver.",
+		SessionPivotDiffers:      "Pivote válido: entra %s y sale %s, aunque la regla del solver haría entrar %s y salir %s.",
+
+		GradeNoTableaux:       "tableaux no puede estar vacío",
+		GradeDimensions:       "La tabla debe tener %d filas y %d columnas.",
+		GradePreviousInvalid:  "No se puede corregir: la tabla anterior no tiene las dimensiones correctas.",
+		GradePivotUnknown:     "No se pudo determinar el pivote: indicá pivot_row y pivot_col (o entering y leaving) en la tabla anterior o la base de cada tabla.",
+		GradeStepCorrect:      "Tabla correcta.",
+		GradeInitialWrong:     "La tabla inicial tiene %d celdas incorrectas.",
+		GradeCellsWrong:       "Las operaciones de fila tienen %d celdas incorrectas.",
+		GradeRuleDiffers:      "El pivote es válido pero no es el que elegiría la regla del solver.",
+		GradeFinalInvalid:     "La tabla final no tiene las dimensiones correctas.",
+		GradeFinalNotFinal:    "La tabla final no es final: todavía quedan pivotes por hacer.",
+		GradeFinalWrongStatus: "La tabla final indica %s pero el problema es %s.",
+		GradeFinalWrongValue:  "La tabla final da Z = %s pero el óptimo es %s.",
+		GradePassed:           "Todas las tablas son correctas y la tabla final resuelve el problema.",
+		GradeFailed:           "%d de %d tablas correctas; el primer error está en la tabla %d.",
+		GradeStepsCorrect:     "Todas las tablas son correctas.",
+
+		GraphDimensions:    "el método gráfico necesita 2 o 3 variables (el modelo tiene %d)",
+		GraphTooLarge:      "el modelo tiene %d restricciones contando cotas y signos; el método gráfico admite hasta %d",
+		GraphSVGDimensions: "el gráfico SVG solo está disponible para modelos de 2 variables",
+
+		VerticesTooMany: "hay demasiadas bases para enumerar: C(%d, %d) supera el límite de %d",
+		VerticesFound:   "%d vértices encontrados al probar %d bases (%d singulares, %d no factibles).",
+
+		TransportEmptySupply:           "supply no puede estar vacío",
+		TransportEmptyDemand:           "demand no puede estar vacío",
+		TransportInvalidAmount:         "las ofertas y demandas deben ser números finitos no negativos",
+		TransportCostRows:              "costs debe tener una fila por origen",
+		TransportCostColumns:           "cada fila de costs debe tener un costo por destino",
+		TransportNonFiniteCost:         "costs contiene valores no finitos",
+		TransportUnknownMethod:         "método inicial desconocido: %s (se admite northwest, least_cost o vogel)",
+		TransportDummySource:           "Origen ficticio",
+		TransportDummyDestination:      "Destino ficticio",
+		TransportDummySourceAdded:      "La demanda supera a la oferta: se agregó un origen ficticio con %s unidades.",
+		TransportDummyDestinationAdded: "La oferta supera a la demanda: se agregó un destino ficticio con %s unidades.",
+		TransportOptimal:               "Costo total mínimo: %s (%d iteraciones de MODI).",
+
+		AssignmentEmptyCosts:      "costs no puede estar vacío",
+		AssignmentRaggedCosts:     "todas las filas de costs deben tener el mismo número de columnas",
+		AssignmentNonFiniteCost:   "costs contiene valores no finitos",
+		AssignmentForbiddenRange:  "la celda prohibida (%d, %d) está fuera de la matriz",
+		AssignmentDummyRow:        "Agente ficticio",
+		AssignmentDummyColumn:     "Tarea ficticia",
+		AssignmentRowReduction:    "Se resta el menor valor de cada fila.",
+		AssignmentColumnReduction: "Se resta el menor valor de cada columna.",
+		AssignmentCover:           "%d líneas cubren todos los ceros; hacen falta %d para asignar.",
+		AssignmentAdjust:          "Se resta %s (el menor valor no cubierto) de las celdas no cubiertas y se suma a las cubiertas dos veces.",
+		AssignmentInfeasible:      "No hay asignación posible: las celdas permitidas no alcanzan para asignar todas las filas.",
+		AssignmentOptimalMin:      "Asignación óptima con costo total %s (%d pasos).",
+		AssignmentOptimalMax:      "Asignación óptima con ganancia total %s (%d pasos).",
+
+		NetworkEmptyNodes:          "nodes no puede estar vacío",
+		NetworkNodeName:            "cada nodo necesita un nombre no vacío y distinto de los demás",
+		NetworkUnknownNode:         "el nodo %s no existe",
+		NetworkSelfLoop:            "un arco no puede empezar y terminar en el mismo nodo",
+		NetworkNonFinite:           "las ofertas y los costos deben ser números finitos",
+		NetworkInvalidBounds:       "las cotas del arco deben cumplir 0 ≤ lower ≤ upper",
+		NetworkUnbalanced:          "la oferta total debe ser igual a la demanda total (la suma de supply es %s)",
+		NetworkSourceSink:          "source y sink deben ser dos nodos distintos de la red",
+		NetworkUnknownProblem:      "problema desconocido: %s (se admite min_cost, max_flow o shortest_path)",
+		NetworkRoot:                "Raíz",
+		NetworkMinCostOptimal:      "Costo total mínimo: %s (%d iteraciones del simplex de redes).",
+		NetworkMaxFlowOptimal:      "Flujo máximo de %s a %s: %s (%d iteraciones del simplex de redes).",
+		NetworkShortestPathOptimal: "Camino más corto de %s a %s: %s, de longitud %s (%d iteraciones del simplex de redes).",
+		NetworkInfiniteFlow:        "Hay un camino de capacidad ilimitada de %s a %s: el flujo máximo es infinito.",
+		NetworkNegativeCycle:       "La red tiene un ciclo de costo negativo: el camino más corto no está definido.",
+		NetworkNoPath:              "No hay ningún camino de %s a %s.",
+
+		GameEmptyPayoffs:    "payoffs no puede estar vacío",
+		GameRaggedPayoffs:   "todas las filas de payoffs deben tener el mismo número de columnas",
+		GameNonFinitePayoff: "payoffs contiene valores no finitos",
+		GameRowDominated:    "La estrategia %s del jugador fila está dominada por %s y se elimina.",
+		GameColumnDominated: "La estrategia %s del jugador columna está dominada por %s y se elimina.",
+		GameSaddlePoint:     "Punto de silla en (%s, %s): el valor del juego es %s con estrategias puras.",
+		GameMixed:           "No hay punto de silla: el valor del juego es %s con estrategias mixtas.",
+
+		GoalEmpty:         "goals no puede estar vacío",
+		GoalColumns:       "se esperaba un coeficiente por variable de decisión (%d)",
+		GoalNonFinite:     "los coeficientes y valores objetivo de las metas deben ser números finitos",
+		GoalType:          "tipo de meta desconocido: %s (se admite ge, le o eq)",
+		GoalWeight:        "el peso de una meta debe ser un número finito no negativo",
+		GoalPriority:      "la prioridad de una meta debe ser un entero positivo",
+		GoalUnknownMethod: "método desconocido: %s (se admite weighted o lexicographic)",
+		GoalSummary:       "Se cumplen %d de %d metas (desviación ponderada mínima por nivel: %s).",
+
+		SyntaxAt:                  "línea %d, columna %d: %s",
+		TokenEOF:                  "fin del modelo",
+		TokenNewline:              "fin de línea",
+		SyntaxUnexpectedChar:      "carácter inesperado '%c'",
+		SyntaxInvalidNumber:       "número inválido '%s'",
+		SyntaxAmbiguousNumber:     "número ambiguo '%s%s': separe el coeficiente de la variable (%s %s) o escriba el exponente con signo (%se+%s)",
+		SyntaxExpectedEndOfLine:   "se esperaba fin de línea, se encontró %s",
+		SyntaxExpectedAfter:       "se esperaba '%s' después de '%s'",
+		SyntaxTrailingContent:     "contenido después de '%s'",
+		SyntaxMissingEnd:          "falta '%s' al final del modelo",
+		SyntaxNoConstraints:       "el modelo no tiene restricciones",
+		SyntaxModelStart:          "el modelo debe comenzar con '%s' o '%s', se encontró %s",
+		SyntaxEmptyObjective:      "la función objetivo no contiene variables",
+		SyntaxObjectiveConstant:   "la función objetivo no puede tener términos constantes",
+		SyntaxExpectedSubjectTo:   "se esperaba 'Subject To', se encontró %s",
+		SyntaxExpectedRelation:    "se esperaba '<=', '>=' o '=', se encontró %s",
+		SyntaxExpectedLE:          "se esperaba '<=', se encontró %s",
+		SyntaxExpectedBound:       "se esperaba '<=', '>=', '=' o 'free', se encontró %s",
+		SyntaxEmptyConstraint:     "la restricción no contiene variables",
+		SyntaxConstantLeft:        "el lado izquierdo de la restricción no puede tener constantes",
+		SyntaxInfiniteRHS:         "el lado derecho debe ser finito",
+		SyntaxDuplicateConstraint: "restricción '%s' duplicada",
+		SyntaxExpectedNumber:      "se esperaba un número, se encontró %s",
+		SyntaxExpectedVariable:    "se esperaba el nombre de una variable, se encontró %s",
+		SyntaxUnknownVariable:     "variable desconocida '%s'",
+		SyntaxIntegerEmpty:        "se esperaba al menos una variable después de 'int'",
+		SyntaxExpectedFactor:      "se esperaba una variable después de '*', se encontró %s",
+		SyntaxExpectedTerm:        "se esperaba un número o una variable, se encontró %s",
+		MPSAt:                     "MPS línea %d: %s",
+		MPSUnknownSection:         "sección desconocida '%s'",
+		MPSOutsideSection:         "datos fuera de una sección",
+		MPSMissingEndata:          "falta ENDATA",
+		MPSNoObjective:            "no hay fila objetivo (tipo N)",
+		MPSObjSense:               "OBJSENSE inválido '%s'",
+		MPSRowFields:              "se esperaba 'tipo nombre' en ROWS",
+		MPSDuplicateRow:           "fila '%s' duplicada",
+		MPSRowType:                "tipo de fila desconocido '%s'",
+		MPSMarker:                 "marcador desconocido '%s'",
+		MPSColumnFields:           "se esperaba 'columna fila valor [fila valor]' en COLUMNS",
+		MPSUnknownRow:             "fila desconocida '%s'",
+		MPSValueFields:            "se esperaba '[conjunto] fila valor [fila valor]' en %s",
+		MPSBoundFields:            "se esperaba 'tipo [conjunto] columna [valor]' en BOUNDS",
+		MPSBoundFieldCount:        "cantidad de campos inválida para la cota %s",
+		MPSUnknownColumn:          "columna desconocida '%s'",
+		MPSBoundType:              "tipo de cota no soportado '%s'",
+		MPSInvalidNumber:          "número inválido '%s'",
+		WriteInvalidName:          "nombre inválido para %s: '%s'",
+		WriteNameTooLong:          "el nombre '%s' supera los 8 caracteres del MPS fijo",
+		SheetRow:                  "fila %d: %s",
+		SheetCell:                 "fila %d, columna %s: %s",
+		SheetTooShort:             "se necesita un encabezado, la fila objetivo y al menos una restricción",
+		SheetType:                 "'%s' debe ser 'max' o 'min'",
+		SheetHeader:               "el encabezado debe tener las columnas 'type' y 'rhs' después de las variables",
+		SheetNotNumeric:           "'%s' no es numérico",
+		SheetConstraintType:       "'%s' no es un tipo de restricción (<=, >=, =)",
+	},
+	English: {
+		EmptyObjective:       "objective vector must not be empty",
+		EmptyConstraints:     "constraints matrix must not be empty",
+		EmptyRHS:             "rhs vector must not be empty",
+		RaggedConstraints:    "all rows of constraints must have the same number of columns",
+		RHSLength:            "the length of rhs does not match the number of rows of constraints",
+		ObjectiveLength:      "the length of objective does not match the number of columns of constraints",
+		NonFiniteObjective:   "objective contains non-finite values",
+		NonFiniteConstraints: "constraints contains non-finite values",
+		NonFiniteRHS:         "rhs contains non-finite values",
+		InvalidType:          "The 'type' field must be 'max' or 'min'",
+		ConstraintSizes:      "the sizes of constraints, RHS and types do not match",
+		EqualityUnsupported:  "the simple solver does not support equality constraints (=); the Big M method is required",
+		UnknownConstraint:    "unknown constraint type: %s",
+		BoundsLength:         "the length of bounds does not match the number of variables",
+		NegativeLowerBound:   "variable %s has a negative lower bound or is free: the solver only supports non-negative variables",
+
+		OptimalReached:  "optimal solution found",
+		FeasibleReached: "feasible solution found",
+		Unbounded:       "unbounded problem",
+		Infeasible:      "infeasible problem",
+
+		StatusOptimal:        "The optimal solution was found",
+		StatusMultiple:       "The objective function is constant: every feasible solution is optimal",
+		StatusUnbounded:      "The problem is unbounded: the objective function can improve indefinitely",
+		StatusInfeasible:     "The problem has no feasible solutions",
+		StatusIterationLimit: "The iteration limit was reached before finding the optimum",
+		StatusDegenerate:     "The optimal basis is degenerate (a basic variable is 0): sensitivity ranges may be one-sided",
+
+		InvalidJSON:        "invalid JSON: %s",
+		InvalidFormat:      "The 'format' parameter must be 'json', 'xlsx' or 'csv'",
+		InvalidMPSFormat:   "The 'format' parameter must be 'free' or 'fixed'",
+		InvalidSheetFormat: "The 'format' parameter must be 'csv' or 'xlsx'",
+		InvalidModel:       "The model has %d errors",
+		UnsupportedModel:   "The model uses %d features the solver does not support",
+
+		ExplainPrimalEntering:  "%s enters because it has the most negative coefficient (%s) in the Z row.",
+		ExplainPrimalRatios:    "Ratio test: %s, so %s leaves (smallest ratio %s).",
+		ExplainPrimalUnbounded: "No row has a positive coefficient in the %s column: the problem is unbounded.",
+		ExplainPrimalOptimal:   "No coefficient in the Z row is negative: the tableau is optimal (Z = %s).",
+		ExplainDualLeaving:     "%s leaves because it has the most negative right-hand side (%s).",
+		ExplainDualRatios:      "Ratios |Z / pivot row| of the negative coefficients: %s, so %s enters (smallest ratio %s).",
+		ExplainDualInfeasible:  "The %s row has no negative coefficients: the problem is infeasible.",
+		ExplainDualOptimal:     "All right-hand sides are non-negative: the tableau is feasible and optimal (Z = %s).",
+		ExplainPivot:           "Pivot element: %s.",
+		ExplainDegenerate:      "This is a degenerate pivot: the minimum ratio is 0, so the basis changes but the solution and the value of Z do not.",
+
+		OptimalFaceUnique:   "The optimal solution is unique.",
+		OptimalFaceVertices: "Every convex combination of the %d optimal vertices (x = Σ λk·vk with λk ≥ 0 and Σ λk = 1) is an optimal solution.",
+		OptimalFaceRays:     "So is any such point plus a non-negative combination of the %d directions (Σ μr·dr with μr ≥ 0).",
+		OptimalFaceRayOnly:  "Every solution x = v1 + Σ μr·dr with μr ≥ 0, where dr are the %d directions, is optimal.",
+
+		DualityHolds:               "Strong duality and complementary slackness hold.",
+		DualityViolated:            "Found %d violations of the duality conditions.",
+		DualityBothInfeasible:      "Both the primal and the dual are infeasible.",
+		DualityUnboundedInfeasible: "One problem is unbounded and the other infeasible, as weak duality predicts.",
+		DualityStatusMismatch:      "The primal is %s but the dual is %s: both should be optimal, or one unbounded and the other infeasible.",
+		DualityStrongViolated:      "The optimal values differ: primal %s, dual %s.",
+		DualitySlackRow:            "Constraint %s has slack %s but its dual variable %s is %s.",
+		DualitySlackVariable:       "Variable %s is %s but its dual constraint has slack %s.",
+
+		UnknownVariable:           "variable %s does not exist in the model",
+		UnknownDual:               "constraint %s does not exist in the model",
+		NonFiniteSolution:         "the solution contains non-finite values",
+		VerifyInfeasible:          "The solution is not feasible: %d constraints are violated.",
+		VerifyOptimal:             "The solution is feasible and optimal (Z = %s).",
+		VerifyNotOptimal:          "The solution is feasible but not optimal: Z = %s and the optimum is %s.",
+		VerifyUnbounded:           "The solution is feasible (Z = %s) but the problem is unbounded: it has no optimum.",
+		VerifyUnknown:             "The solution is feasible, but the model could not be solved to check optimality.",
+		VerifyDualsNotCertificate: "The given duals do not certify optimality: they are not feasible or the duality gap is not 0.",
+
+		SessionNotFound:          "session %s does not exist or has expired",
+		SessionNothingToUndo:     "there are no pivots to undo",
+		SessionInProgress:        "Choose the next pivot (tableau row and column).",
+		SessionFinished:          "The tableau is already final: there are no pivots left.",
+		SessionPivotOutOfRange:   "Row %d or column %d is not a possible pivot in the tableau.",
+		SessionPivotBasic:        "%s is already basic: it cannot enter the basis.",
+		SessionPivotNotImproving: "%s does not improve the objective: its coefficient in the Z row (%s) is not negative.",
+		SessionPivotNonPositive:  "The pivot element (%s) must be positive.",
+		SessionPivotNotMinRatio:  "The %s row does not have the smallest ratio (%s; the minimum is %s): the solution would become infeasible.",
+		SessionDualRowFeasible:   "The %s row has a non-negative right-hand side (%s): in the dual simplex a variable with a negative value leaves.",
+		SessionDualNonNegative:   "The pivot element (%s) must be negative in the dual simplex.",
+		SessionDualNotMinRatio:   "The %s column does not have the smallest ratio (%s; the minimum is %s): the tableau would stop being optimal.",
+		SessionPivotMatches:      "Correct pivot: %s enters and %s leaves, as the solver's rule says.",
+		SessionPivotDiffers:      "Valid pivot: %s enters and %s leaves, although the solver's rule would bring in %s and take out %s.",
+
+		GradeNoTableaux:       "tableaux must not be empty",
+		GradeDimensions:       "The tableau must have %d rows and %d columns.",
+		GradePreviousInvalid:  "Cannot be graded: the previous tableau does not have the right dimensions.",
+		GradePivotUnknown:     "The pivot could not be determined: give pivot_row and pivot_col (or entering and leaving) in the previous tableau, or the basis of each tableau.",
+		GradeStepCorrect:      "Correct tableau.",
+		GradeInitialWrong:     "The initial tableau has %d wrong cells.",
+		GradeCellsWrong:       "The row operations have %d wrong cells.",
+		GradeRuleDiffers:      "The pivot is valid but it is not the one the solver's rule would choose.",
+		GradeFinalInvalid:     "The final tableau does not have the right dimensions.",
+		GradeFinalNotFinal:    "The final tableau is not final: there are pivots left.",
+		GradeFinalWrongStatus: "The final tableau says %s but the problem is %s.",
+		GradeFinalWrongValue:  "The final tableau gives Z = %s but the optimum is %s.",
+		GradePassed:           "All tableaux are correct and the final tableau solves the problem.",
+		GradeFailed:           "%d of %d tableaux correct; the first error is in tableau %d.",
+		GradeStepsCorrect:     "All tableaux are correct.",
+
+		GraphDimensions:    "the graphical method needs 2 or 3 variables (the model has %d)",
+		GraphTooLarge:      "the model has %d constraints counting bounds and signs; the graphical method allows up to %d",
+		GraphSVGDimensions: "the SVG plot is only available for models with 2 variables",
+
+		VerticesTooMany: "there are too many bases to enumerate: C(%d, %d) exceeds the limit of %d",
+		VerticesFound:   "%d vertices found trying %d bases (%d singular, %d infeasible).",
+
+		TransportEmptySupply:           "supply must not be empty",
+		TransportEmptyDemand:           "demand must not be empty",
+		TransportInvalidAmount:         "supplies and demands must be finite non-negative numbers",
+		TransportCostRows:              "costs must have one row per source",
+		TransportCostColumns:           "each row of costs must have one cost per destination",
+		TransportNonFiniteCost:         "costs contains non-finite values",
+		TransportUnknownMethod:         "unknown initial method: %s (northwest, least_cost or vogel are allowed)",
+		TransportDummySource:           "Dummy source",
+		TransportDummyDestination:      "Dummy destination",
+		TransportDummySourceAdded:      "Demand exceeds supply: a dummy source with %s units was added.",
+		TransportDummyDestinationAdded: "Supply exceeds demand: a dummy destination with %s units was added.",
+		TransportOptimal:               "Minimum total cost: %s (%d MODI iterations).",
+
+		AssignmentEmptyCosts:      "costs must not be empty",
+		AssignmentRaggedCosts:     "all rows of costs must have the same number of columns",
+		AssignmentNonFiniteCost:   "costs contains non-finite values",
+		AssignmentForbiddenRange:  "the forbidden cell (%d, %d) is outside the matrix",
+		AssignmentDummyRow:        "Dummy agent",
+		AssignmentDummyColumn:     "Dummy task",
+		AssignmentRowReduction:    "The smallest value of each row is subtracted.",
+		AssignmentColumnReduction: "The smallest value of each column is subtracted.",
+		AssignmentCover:           "%d lines cover all zeros; %d are needed to assign.",
+		AssignmentAdjust:          "%s (the smallest uncovered value) is subtracted from the uncovered cells and added to the cells covered twice.",
+		AssignmentInfeasible:      "No assignment is possible: the allowed cells are not enough to assign every row.",
+		AssignmentOptimalMin:      "Optimal assignment with total cost %s (%d steps).",
+		AssignmentOptimalMax:      "Optimal assignment with total profit %s (%d steps).",
+
+		NetworkEmptyNodes:          "nodes must not be empty",
+		NetworkNodeName:            "each node needs a non-empty name different from the others",
+		NetworkUnknownNode:         "node %s does not exist",
+		NetworkSelfLoop:            "an arc cannot start and end at the same node",
+		NetworkNonFinite:           "supplies and costs must be finite numbers",
+		NetworkInvalidBounds:       "the arc bounds must satisfy 0 ≤ lower ≤ upper",
+		NetworkUnbalanced:          "total supply must equal total demand (supply adds up to %s)",
+		NetworkSourceSink:          "source and sink must be two different nodes of the network",
+		NetworkUnknownProblem:      "unknown problem: %s (min_cost, max_flow or shortest_path are allowed)",
+		NetworkRoot:                "Root",
+		NetworkMinCostOptimal:      "Minimum total cost: %s (%d network simplex iterations).",
+		NetworkMaxFlowOptimal:      "Maximum flow from %s to %s: %s (%d network simplex iterations).",
+		NetworkShortestPathOptimal: "Shortest path from %s to %s: %s, with length %s (%d network simplex iterations).",
+		NetworkInfiniteFlow:        "There is a path of unlimited capacity from %s to %s: the maximum flow is infinite.",
+		NetworkNegativeCycle:       "The network has a negative-cost cycle: the shortest path is not defined.",
+		NetworkNoPath:              "There is no path from %s to %s.",
+
+		GameEmptyPayoffs:    "payoffs must not be empty",
+		GameRaggedPayoffs:   "all rows of payoffs must have the same number of columns",
+		GameNonFinitePayoff: "payoffs contains non-finite values",
+		GameRowDominated:    "Strategy %s of the row player is dominated by %s and is removed.",
+		GameColumnDominated: "Strategy %s of the column player is dominated by %s and is removed.",
+		GameSaddlePoint:     "Saddle point at (%s, %s): the value of the game is %s with pure strategies.",
+		GameMixed:           "There is no saddle point: the value of the game is %s with mixed strategies.",
+
+		GoalEmpty:         "goals must not be empty",
+		GoalColumns:       "one coefficient per decision variable (%d) was expected",
+		GoalNonFinite:     "goal coefficients and targets must be finite numbers",
+		GoalType:          "unknown goal type: %s (ge, le or eq are allowed)",
+		GoalWeight:        "the weight of a goal must be a finite non-negative number",
+		GoalPriority:      "the priority of a goal must be a positive integer",
+		GoalUnknownMethod: "unknown method: %s (weighted or lexicographic are allowed)",
+		GoalSummary:       "%d of %d goals are met (minimum weighted deviation per level: %s).",
+
+		SyntaxAt:                  "line %d, column %d: %s",
+		TokenEOF:                  "end of model",
+		TokenNewline:              "end of line",
+		SyntaxUnexpectedChar:      "unexpected character '%c'",
+		SyntaxInvalidNumber:       "invalid number '%s'",
+		SyntaxAmbiguousNumber:     "ambiguous number '%s%s': separate the coefficient from the variable (%s %s) or write the exponent with a sign (%se+%s)",
+		SyntaxExpectedEndOfLine:   "expected end of line, found %s",
+		SyntaxExpectedAfter:       "expected '%s' after '%s'",
+		SyntaxTrailingContent:     "content after '%s'",
+		SyntaxMissingEnd:          "missing '%s' at the end of the model",
+		SyntaxNoConstraints:       "the model has no constraints",
+		SyntaxModelStart:          "the model must start with '%s' or '%s', found %s",
+		SyntaxEmptyObjective:      "the objective function has no variables",
+		SyntaxObjectiveConstant:   "the objective function cannot have constant terms",
+		SyntaxExpectedSubjectTo:   "expected 'Subject To', found %s",
+		SyntaxExpectedRelation:    "expected '<=', '>=' or '=', found %s",
+		SyntaxExpectedLE:          "expected '<=', found %s",
+		SyntaxExpectedBound:       "expected '<=', '>=', '=' or 'free', found %s",
+		SyntaxEmptyConstraint:     "the constraint has no variables",
+		SyntaxConstantLeft:        "the left-hand side of a constraint cannot have constants",
+		SyntaxInfiniteRHS:         "the right-hand side must be finite",
+		SyntaxDuplicateConstraint: "duplicate constraint '%s'",
+		SyntaxExpectedNumber:      "expected a number, found %s",
+		SyntaxExpectedVariable:    "expected a variable name, found %s",
+		SyntaxUnknownVariable:     "unknown variable '%s'",
+		SyntaxIntegerEmpty:        "expected at least one variable after 'int'",
+		SyntaxExpectedFactor:      "expected a variable after '*', found %s",
+		SyntaxExpectedTerm:        "expected a number or a variable, found %s",
+		MPSAt:                     "MPS line %d: %s",
+		MPSUnknownSection:         "unknown section '%s'",
+		MPSOutsideSection:         "data outside a section",
+		MPSMissingEndata:          "missing ENDATA",
+		MPSNoObjective:            "there is no objective row (type N)",
+		MPSObjSense:               "invalid OBJSENSE '%s'",
+		MPSRowFields:              "expected 'type name' in ROWS",
+		MPSDuplicateRow:           "duplicate row '%s'",
+		MPSRowType:                "unknown row type '%s'",
+		MPSMarker:                 "unknown marker '%s'",
+		MPSColumnFields:           "expected 'column row value [row value]' in COLUMNS",
+		MPSUnknownRow:             "unknown row '%s'",
+		MPSValueFields:            "expected '[set] row value [row value]' in %s",
+		MPSBoundFields:            "expected 'type [set] column [value]' in BOUNDS",
+		MPSBoundFieldCount:        "invalid number of fields for bound %s",
+		MPSUnknownColumn:          "unknown column '%s'",
+		MPSBoundType:              "unsupported bound type '%s'",
+		MPSInvalidNumber:          "invalid number '%s'",
+		WriteInvalidName:          "invalid name for %s: '%s'",
+		WriteNameTooLong:          "the name '%s' exceeds the 8 characters of fixed MPS",
+		SheetRow:                  "row %d: %s",
+		SheetCell:                 "row %d, column %s: %s",
+		SheetTooShort:             "a header, the objective row and at least one constraint are required",
+		SheetType:                 "'%s' must be 'max' or 'min'",
+		SheetHeader:               "the header must have the 'type' and 'rhs' columns after the variables",
+		SheetNotNumeric:           "'%s' is not numeric",
+		SheetConstraintType:       "'%s' is not a constraint type (<=, >=, =)",
+	},
+}
@@ -0,0 +1,111 @@
+package i18n
+
+import (
+	"errors"
+	"fmt"
+
+	"golang.org/x/text/language"
+)
+
+// Idiomas soportados por el catálogo de mensajes
+const (
+	Spanish = "es"
+	English = "en"
+
+	DefaultLanguage = Spanish
+)
+
+// Code identifica un mensaje del catálogo (errores, estados y explicaciones)
+type Code string
+
+var matcher = language.NewMatcher([]language.Tag{language.Spanish, language.English})
+
+// Normalize convierte un idioma pedido ("en", "en-US", "es-AR"...) en uno soportado;
+// por defecto español
+func Normalize(lang string) string {
+	if lang == "" {
+		return DefaultLanguage
+	}
+	return FromAcceptLanguage(lang)
+}
+
+// FromAcceptLanguage elige el idioma soportado que mejor coincide con un encabezado
+// Accept-Language ("en-US,en;q=0.9,es;q=0.8"); por defecto español
+func FromAcceptLanguage(header string) string {
+	tags, _, err := language.ParseAcceptLanguage(header)
+	if err != nil || len(tags) == 0 {
+		return DefaultLanguage
+	}
+	_, index, confidence := matcher.Match(tags...)
+	if confidence == language.No || index == 0 {
+		return Spanish
+	}
+	return English
+}
+
+// Message devuelve el mensaje del código en el idioma pedido, con los argumentos
+// aplicados como en fmt.Sprintf (los argumentos de tipo Code se traducen al mismo
+// idioma). Si falta la traducción se usa el español y, si el código no existe, el
+// propio código.
+func Message(lang string, code Code, args ...any) string {
+	text, ok := catalog[Normalize(lang)][code]
+	if !ok {
+		if text, ok = catalog[DefaultLanguage][code]; !ok {
+			return string(code)
+		}
+	}
+	if len(args) == 0 {
+		return text
+	}
+	localized := make([]any, len(args))
+	for i, arg := range args {
+		if c, ok := arg.(Code); ok {
+			arg = Message(lang, c)
+		}
+		localized[i] = arg
+	}
+	return fmt.Sprintf(text, localized...)
+}
+
+// Error es un error con código tipado: el texto se resuelve en el catálogo recién al
+// mostrarlo, así el mismo error se puede informar en cualquier idioma
+type Error struct {
+	Code Code
+	Args []any
+}
+
+// New crea un error del catálogo
+func New(code Code, args ...any) *Error {
+	return &Error{Code: code, Args: args}
+}
+
+// Error devuelve el mensaje en el idioma por defecto
+func (e *Error) Error() string {
+	return Message(DefaultLanguage, e.Code, e.Args...)
+}
+
+// Is compara por código, de modo que errors.Is(err, ErrX) funciona aunque el error
+// lleve argumentos distintos
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Code == e.Code
+}
+
+// Localizer es un error que sabe traducirse (p. ej. una lista de errores del catálogo)
+type Localizer interface {
+	Localize(lang string) string
+}
+
+// Localize devuelve el mensaje del error en el idioma pedido; los errores que no son
+// del catálogo se devuelven tal cual
+func Localize(err error, lang string) string {
+	var l Localizer
+	if errors.As(err, &l) {
+		return l.Localize(lang)
+	}
+	var e *Error
+	if errors.As(err, &e) {
+		return Message(lang, e.Code, e.Args...)
+	}
+	return err.Error()
+}
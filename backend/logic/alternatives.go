@@ -0,0 +1,183 @@
+package logic
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"proyecto/simplex/i18n"
+	"proyecto/simplex/models"
+)
+
+// Límites de la enumeración de soluciones óptimas alternativas
+const (
+	defaultMaxAlternatives = 10
+	maxAlternativesLimit   = 100
+)
+
+// optimalBasis es una base óptima pendiente de explorar
+type optimalBasis struct {
+	tableau models.SimplexTableau
+	basis   []int
+}
+
+// addAlternatives enumera las soluciones básicas óptimas a partir de la tabla final
+// y describe la cara óptima que generan. lower son las cotas inferiores corridas por
+// shiftLowerBounds, que se suman a cada vértice (nil si no hay).
+func addAlternatives(result *models.SimplexResponse, req models.SimplexRequest, lower []float64) {
+	if result.State != models.StatusOptimal || result.FinalTableau == nil || len(result.TableauxHistory) == 0 {
+		return
+	}
+
+	limit := req.MaxAlternatives
+	if limit <= 0 {
+		limit = defaultMaxAlternatives
+	}
+	limit = min(limit, maxAlternativesLimit)
+
+	headers := result.TableauxHistory[len(result.TableauxHistory)-1].Headers
+	solutions, rays, complete := enumerateOptimalBases(headers, result.FinalTableau, result.FinalBasis, limit)
+	for _, s := range solutions {
+		for j, l := range lower {
+			s.Variables[headers[j+1]] = roundValue(s.Variables[headers[j+1]] + l)
+		}
+	}
+
+	face := &models.OptimalFace{Rays: rays, Complete: complete}
+	for _, s := range solutions {
+		face.Vertices = append(face.Vertices, s.Variables)
+	}
+	face.Description = faceDescription(len(face.Vertices), len(rays), req.Language)
+
+	result.Alternatives = solutions
+	result.OptimalFace = face
+}
+
+// enumerateOptimalBases recorre las bases óptimas adyacentes pivoteando sobre las
+// columnas no básicas con costo reducido 0 (el valor de Z no cambia). Devuelve los
+// vértices óptimos distintos, las direcciones no acotadas de la cara óptima (columnas
+// sin cociente válido) e indica si se exploró todo antes de llegar al límite.
+func enumerateOptimalBases(headers []string, tableau models.SimplexTableau, basis []int, limit int) ([]models.BasicSolution, []map[string]float64, bool) {
+	numConstraints := len(tableau) - 1
+	numVariables := len(headers) - 2 - numConstraints
+
+	var solutions []models.BasicSolution
+	var rays []map[string]float64
+	seenBases := map[string]bool{basisKey(basis): true}
+	seenPoints := map[string]bool{}
+	seenRays := map[string]bool{}
+
+	queue := []optimalBasis{{tableau: tableau, basis: append([]int(nil), basis...)}}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		solution := basicSolution(headers, numVariables, current.tableau, current.basis)
+		if key := pointKey(headers, numVariables, solution.Variables); !seenPoints[key] {
+			if len(solutions) == limit {
+				return solutions, rays, false
+			}
+			seenPoints[key] = true
+			solutions = append(solutions, solution)
+		}
+
+		for _, col := range zeroReducedCostColumns(current.tableau, current.basis) {
+			ratios := ratioTest(current.tableau, col)
+			best := math.Inf(1)
+			for _, r := range ratios {
+				best = math.Min(best, r)
+			}
+
+			// Sin cociente válido: la variable crece sin límite sin cambiar Z
+			if math.IsInf(best, 1) {
+				ray := rayDirection(headers, numVariables, current.tableau, current.basis, col)
+				if key := pointKey(headers, numVariables, ray); !seenRays[key] {
+					seenRays[key] = true
+					rays = append(rays, ray)
+				}
+				continue
+			}
+
+			// Con empates se prueban todas las filas: llevan a bases distintas
+			for row, r := range ratios {
+				if math.Abs(r-best) > 1e-9 {
+					continue
+				}
+				next := append([]int(nil), current.basis...)
+				next[row-1] = col
+				if seenBases[basisKey(next)] {
+					continue
+				}
+				seenBases[basisKey(next)] = true
+				queue = append(queue, optimalBasis{tableau: pivot(current.tableau, row, col), basis: next})
+			}
+		}
+	}
+	return solutions, rays, true
+}
+
+// basicSolution arma la solución básica de la tabla: valor de las variables de
+// decisión (truncado) y nombres de las variables básicas
+func basicSolution(headers []string, numVariables int, tableau models.SimplexTableau, basis []int) models.BasicSolution {
+	rhsCol := len(tableau[0]) - 1
+	solution := models.BasicSolution{Variables: make(map[string]float64, numVariables)}
+	for j := 1; j <= numVariables; j++ {
+		solution.Variables[headers[j]] = 0
+	}
+	for i, col := range basis {
+		if col <= numVariables {
+			solution.Variables[headers[col]] = truncate(tableau[i+1][rhsCol])
+		}
+		solution.Basis = append(solution.Basis, headers[col])
+	}
+	return solution
+}
+
+// rayDirection es la dirección en la que se mueven las variables de decisión al
+// aumentar en 1 la variable no básica col (las básicas bajan según su coeficiente)
+func rayDirection(headers []string, numVariables int, tableau models.SimplexTableau, basis []int, col int) map[string]float64 {
+	ray := make(map[string]float64, numVariables)
+	for j := 1; j <= numVariables; j++ {
+		ray[headers[j]] = 0
+	}
+	if col <= numVariables {
+		ray[headers[col]] = 1
+	}
+	for i, b := range basis {
+		if b <= numVariables {
+			ray[headers[b]] = truncate(-tableau[i+1][col]) + 0 // + 0 evita "-0"
+		}
+	}
+	return ray
+}
+
+func basisKey(basis []int) string {
+	sorted := slices.Clone(basis)
+	slices.Sort(sorted)
+	return fmt.Sprint(sorted)
+}
+
+func pointKey(headers []string, numVariables int, values map[string]float64) string {
+	parts := make([]string, numVariables)
+	for j := 1; j <= numVariables; j++ {
+		parts[j-1] = fmt.Sprint(values[headers[j]])
+	}
+	return strings.Join(parts, ",")
+}
+
+// faceDescription explica la cara óptima como combinación convexa de los vértices
+// más combinación no negativa de los rayos
+func faceDescription(vertices, rays int, lang string) string {
+	switch {
+	case vertices == 1 && rays == 0:
+		return i18n.Message(lang, i18n.OptimalFaceUnique)
+	case vertices == 1:
+		return i18n.Message(lang, i18n.OptimalFaceRayOnly, rays)
+	}
+	description := i18n.Message(lang, i18n.OptimalFaceVertices, vertices)
+	if rays > 0 {
+		description += " " + i18n.Message(lang, i18n.OptimalFaceRays, rays)
+	}
+	return description
+}
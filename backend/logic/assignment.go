@@ -0,0 +1,308 @@
+package logic
+
+import (
+	"fmt"
+	"math"
+	"slices"
+
+	"proyecto/simplex/i18n"
+	"proyecto/simplex/models"
+)
+
+// SolveAssignment resuelve el problema de asignación con el método húngaro: completa
+// la matriz hasta hacerla cuadrada, la convierte a minimización si se maximiza, resta
+// el mínimo de cada fila y de cada columna y, mientras no alcance con los ceros para
+// asignar todas las filas, cubre los ceros con el mínimo de líneas y ajusta la matriz
+// con el menor valor no cubierto. Las celdas prohibidas valen +Inf.
+func SolveAssignment(req models.AssignmentRequest) (models.AssignmentResponse, error) {
+	if err := validateAssignment(req); err != nil {
+		return models.AssignmentResponse{}, err
+	}
+	lang := req.Language
+	rows, cols := len(req.Costs), len(req.Costs[0])
+	n := max(rows, cols)
+
+	response := models.AssignmentResponse{Status: models.StatusOptimal}
+	for i := range n {
+		name := i18n.Message(lang, i18n.AssignmentDummyRow)
+		if i < rows {
+			name = transportName(req.RowNames, "A", i)
+		}
+		response.Rows = append(response.Rows, name)
+	}
+	for j := range n {
+		name := i18n.Message(lang, i18n.AssignmentDummyColumn)
+		if j < cols {
+			name = transportName(req.ColumnNames, "T", j)
+		}
+		response.Columns = append(response.Columns, name)
+	}
+
+	matrix := assignmentMatrix(req, n)
+	response.Matrix = assignmentCells(matrix)
+	tol := assignmentTolerance(matrix)
+	addStep := func(step models.AssignmentStep) {
+		step.Matrix = assignmentCells(matrix)
+		response.Steps = append(response.Steps, step)
+	}
+
+	// Reducción de filas y de columnas
+	for i := range matrix {
+		if m := slices.Min(matrix[i]); !math.IsInf(m, 1) {
+			for j := range matrix[i] {
+				matrix[i][j] -= m
+			}
+		}
+	}
+	addStep(models.AssignmentStep{Kind: models.AssignmentRowReduction, Description: i18n.Message(lang, i18n.AssignmentRowReduction)})
+	for j := range n {
+		m := math.Inf(1)
+		for i := range matrix {
+			m = math.Min(m, matrix[i][j])
+		}
+		if !math.IsInf(m, 1) {
+			for i := range matrix {
+				matrix[i][j] -= m
+			}
+		}
+	}
+	addStep(models.AssignmentStep{Kind: models.AssignmentColumnReduction, Description: i18n.Message(lang, i18n.AssignmentColumnReduction)})
+
+	var match []int
+	for {
+		var size int
+		match, size = zeroMatching(matrix, tol)
+		if size == n {
+			break
+		}
+		coveredRows, coveredCols := zeroCover(matrix, match, tol)
+		addStep(models.AssignmentStep{
+			Kind:           models.AssignmentCover,
+			CoveredRows:    coveredRows,
+			CoveredColumns: coveredCols,
+			Description:    i18n.Message(lang, i18n.AssignmentCover, len(coveredRows)+len(coveredCols), n),
+		})
+
+		// Menor valor no cubierto: se resta de las celdas no cubiertas y se suma a las
+		// cubiertas dos veces. Si no hay ninguno finito, las celdas permitidas no
+		// alcanzan para asignar todas las filas.
+		k := math.Inf(1)
+		for i := range matrix {
+			for j := range matrix[i] {
+				if !slices.Contains(coveredRows, i) && !slices.Contains(coveredCols, j) {
+					k = math.Min(k, matrix[i][j])
+				}
+			}
+		}
+		if math.IsInf(k, 1) {
+			response.Status = models.StatusInfeasible
+			response.Message = i18n.Message(lang, i18n.AssignmentInfeasible)
+			return response, nil
+		}
+		for i := range matrix {
+			for j := range matrix[i] {
+				rowCovered, colCovered := slices.Contains(coveredRows, i), slices.Contains(coveredCols, j)
+				switch {
+				case !rowCovered && !colCovered:
+					matrix[i][j] -= k
+				case rowCovered && colCovered:
+					matrix[i][j] += k
+				}
+			}
+		}
+		addStep(models.AssignmentStep{
+			Kind:        models.AssignmentAdjust,
+			CoveredRows: coveredRows, CoveredColumns: coveredCols,
+			Value:       truncate(k),
+			Description: i18n.Message(lang, i18n.AssignmentAdjust, formatValue(truncate(k))),
+		})
+	}
+
+	// match[j] es la fila asignada a la columna j
+	for j, i := range match {
+		switch {
+		case i < rows && j < cols:
+			response.Assignments = append(response.Assignments, models.Assignment{
+				AssignmentCell: models.AssignmentCell{Row: i, Col: j},
+				RowName:        response.Rows[i],
+				ColumnName:     response.Columns[j],
+				Cost:           req.Costs[i][j],
+			})
+			response.Total += req.Costs[i][j]
+		case i < rows:
+			response.Unassigned = append(response.Unassigned, response.Rows[i])
+		case j < cols:
+			response.Unassigned = append(response.Unassigned, response.Columns[j])
+		}
+	}
+	slices.SortFunc(response.Assignments, func(a, b models.Assignment) int { return a.Row - b.Row })
+	response.Total = truncate(response.Total) + 0
+
+	code := i18n.AssignmentOptimalMin
+	if req.Type == "max" {
+		code = i18n.AssignmentOptimalMax
+	}
+	response.Message = i18n.Message(lang, code, formatValue(response.Total), len(response.Steps))
+	return response, nil
+}
+
+// validateAssignment verifica la matriz, el tipo y las celdas prohibidas
+func validateAssignment(req models.AssignmentRequest) error {
+	var errs ValidationErrors
+	if len(req.Costs) == 0 || len(req.Costs[0]) == 0 {
+		errs = append(errs, newFieldError("costs", i18n.AssignmentEmptyCosts))
+	}
+	for i, row := range req.Costs {
+		if len(row) != len(req.Costs[0]) {
+			fe := newFieldError(fmt.Sprintf("costs[%d]", i), i18n.AssignmentRaggedCosts)
+			fe.Details = map[string]any{"expected": len(req.Costs[0]), "got": len(row)}
+			errs = append(errs, fe)
+		}
+		for j, v := range row {
+			if !isFinite(v) {
+				errs = append(errs, nonFinite(fmt.Sprintf("costs[%d][%d]", i, j), i18n.AssignmentNonFiniteCost, v))
+			}
+		}
+	}
+	if req.Type != "" && req.Type != "max" && req.Type != "min" {
+		errs = append(errs, &FieldError{Field: "type", Err: ErrInvalidType, Details: map[string]any{"value": req.Type}})
+	}
+	for k, cell := range req.Forbidden {
+		if len(req.Costs) == 0 || cell.Row < 0 || cell.Row >= len(req.Costs) || cell.Col < 0 || cell.Col >= len(req.Costs[0]) {
+			errs = append(errs, newFieldError(fmt.Sprintf("forbidden[%d]", k), i18n.AssignmentForbiddenRange, cell.Row, cell.Col))
+		}
+	}
+	return errs.orNil()
+}
+
+// assignmentMatrix arma la matriz cuadrada a minimizar: con type "max" cada ganancia
+// se resta de la mayor, las celdas ficticias valen 0 y las prohibidas +Inf
+func assignmentMatrix(req models.AssignmentRequest, n int) [][]float64 {
+	largest := math.Inf(-1)
+	for _, row := range req.Costs {
+		largest = math.Max(largest, slices.Max(row))
+	}
+
+	matrix := make([][]float64, n)
+	for i := range matrix {
+		matrix[i] = make([]float64, n)
+		for j := range matrix[i] {
+			if i >= len(req.Costs) || j >= len(req.Costs[0]) {
+				continue
+			}
+			matrix[i][j] = req.Costs[i][j]
+			if req.Type == "max" {
+				matrix[i][j] = largest - req.Costs[i][j]
+			}
+		}
+	}
+	for _, cell := range req.Forbidden {
+		matrix[cell.Row][cell.Col] = math.Inf(1)
+	}
+	return matrix
+}
+
+// assignmentCells copia la matriz para la respuesta (truncada, null en las prohibidas)
+func assignmentCells(matrix [][]float64) [][]*float64 {
+	cells := make([][]*float64, len(matrix))
+	for i, row := range matrix {
+		cells[i] = make([]*float64, len(row))
+		for j, v := range row {
+			if !math.IsInf(v, 1) {
+				value := truncate(v) + 0
+				cells[i][j] = &value
+			}
+		}
+	}
+	return cells
+}
+
+func assignmentTolerance(matrix [][]float64) float64 {
+	var values []float64
+	for _, row := range matrix {
+		for _, v := range row {
+			if !math.IsInf(v, 1) {
+				values = append(values, v)
+			}
+		}
+	}
+	return tolerance(values...)
+}
+
+// zeroMatching busca la mayor asignación usando solo ceros (caminos de aumento):
+// match[j] es la fila asignada a la columna j (-1 si no tiene)
+func zeroMatching(matrix [][]float64, tol float64) ([]int, int) {
+	n := len(matrix)
+	match := make([]int, n)
+	for j := range match {
+		match[j] = -1
+	}
+	var augment func(i int, visited []bool) bool
+	augment = func(i int, visited []bool) bool {
+		for j := range n {
+			if visited[j] || math.Abs(matrix[i][j]) > tol {
+				continue
+			}
+			visited[j] = true
+			if match[j] < 0 || augment(match[j], visited) {
+				match[j] = i
+				return true
+			}
+		}
+		return false
+	}
+	size := 0
+	for i := range n {
+		if augment(i, make([]bool, n)) {
+			size++
+		}
+	}
+	return match, size
+}
+
+// zeroCover arma el mínimo de líneas que cubren todos los ceros a partir de la mayor
+// asignación (teorema de König): se marcan las filas sin asignar y, alternando, las
+// columnas con ceros en filas marcadas y las filas asignadas a esas columnas. Las
+// líneas son las filas sin marcar y las columnas marcadas.
+func zeroCover(matrix [][]float64, match []int, tol float64) ([]int, []int) {
+	n := len(matrix)
+	assigned := make([]bool, n)
+	for _, i := range match {
+		if i >= 0 {
+			assigned[i] = true
+		}
+	}
+	markedRows, markedCols := make([]bool, n), make([]bool, n)
+	var queue []int
+	for i := range n {
+		if !assigned[i] {
+			markedRows[i] = true
+			queue = append(queue, i)
+		}
+	}
+	for len(queue) > 0 {
+		i := queue[0]
+		queue = queue[1:]
+		for j := range n {
+			if markedCols[j] || math.Abs(matrix[i][j]) > tol {
+				continue
+			}
+			markedCols[j] = true
+			if r := match[j]; r >= 0 && !markedRows[r] {
+				markedRows[r] = true
+				queue = append(queue, r)
+			}
+		}
+	}
+
+	var rows, cols []int
+	for i := range n {
+		if !markedRows[i] {
+			rows = append(rows, i)
+		}
+		if markedCols[i] {
+			cols = append(cols, i)
+		}
+	}
+	return rows, cols
+}
@@ -1,14 +1,16 @@
 package logic
 
 import (
-	"errors"
+	"fmt"
+
+	"proyecto/simplex/i18n"
 )
 
 // StandardizeConstraints convierte las restricciones a la forma canónica (solo <=)
 // Esto multiplica por -1 las restricciones GE (>=) y devuelve error en EQ (=).
 func StandardizeConstraints(constraints [][]float64, rhs []float64, types []string) ([][]float64, []float64, error) {
 	if len(constraints) != len(types) || len(constraints) != len(rhs) {
-		return nil, nil, errors.New("los tamaños de las restricciones, RHS y tipos no coinciden")
+		return nil, nil, newFieldError("constraint_types", i18n.ConstraintSizes)
 	}
 
 	newConstraints := make([][]float64, len(constraints))
@@ -30,9 +32,9 @@ func StandardizeConstraints(constraints [][]float64, rhs []float64, types []stri
 			}
 			newRHS[i] *= -1.0
 		case "eq": // Igualdad (=): Requiere Gran M.
-			return nil, nil, errors.New("solver simple no soporta restricciones de igualdad (=). Se necesita el método de Gran M")
+			return nil, nil, newUnsupportedError(fmt.Sprintf("constraint_types[%d]", i), ErrEqualityUnsupported)
 		default:
-			return nil, nil, errors.New("tipo de restricción no reconocido: " + constraintType)
+			return nil, nil, newFieldError(fmt.Sprintf("constraint_types[%d]", i), i18n.UnknownConstraint, constraintType)
 		}
 	}
 
@@ -4,7 +4,6 @@ import (
 	"errors"
 	"fmt"
 	"math"
-	"strings"
 
 	"proyecto/simplex/models"
 )
@@ -12,12 +11,13 @@ import (
 // --- Lógica del Método Simplex Dual (Usado para MIN con RHS negativo) ---
 
 // findDualPivotRow encuentra la fila pivote (variable que sale)
-// Simplex Dual: Fila con el valor RHS más NEGATIVO.
+// Simplex Dual: Fila con el valor RHS más NEGATIVO. Los RHS apenas negativos por
+// errores de redondeo (p. ej. una fila que quedó en 0) se consideran factibles.
 func findDualPivotRow(tableau models.SimplexTableau) (int, error) {
 	numRows := len(tableau)
 	rhsCol := len(tableau[0]) - 1
 
-	minRHS := 0.0
+	minRHS := -1e-9
 	pivotRow := -1
 
 	// Iterar sobre las filas de restricción (índice 1 en adelante)
@@ -30,37 +30,50 @@ func findDualPivotRow(tableau models.SimplexTableau) (int, error) {
 	}
 
 	if pivotRow == -1 {
-		return -1, errors.New("solución factible encontrada")
+		return -1, ErrFeasibleReached
 	}
 	return pivotRow, nil
 }
 
-// findDualPivotColumn encuentra la columna pivote (variable que entra)
-// Simplex Dual: Mínimo cociente (Fila Z / Elemento Pivote), solo para elementos negativos en la Fila Pivote.
-func findDualPivotColumn(tableau models.SimplexTableau, pivotRow int) (int, error) {
+// dualRatioTest calcula el cociente |Z[j] / elemento de la fila pivote| para cada
+// columna de la tabla. Solo participan los elementos NEGATIVOS de la fila pivote;
+// el resto (y las columnas Z y RHS) vale +Inf.
+func dualRatioTest(tableau models.SimplexTableau, pivotRow int) []float64 {
 	numCols := len(tableau[0])
 	zRow := tableau[Z_ROW_INDEX]
 	pivotElements := tableau[pivotRow]
 
-	minRatio := math.Inf(1)
-	pivotCol := -1
+	ratios := make([]float64, numCols)
+	for j := range ratios {
+		ratios[j] = math.Inf(1)
+	}
 
 	// Iterar sobre las columnas de variables (índice 1 hasta len-2)
 	for j := 1; j < numCols-1; j++ {
 		pivotElement := pivotElements[j]
 		if pivotElement < -1e-9 { // Solo considerar coeficientes NEGATIVOS en la fila pivote
 			// Cociente Z[j] / |Pivot[j]|
-			ratio := math.Abs(zRow[j] / pivotElement)
+			ratios[j] = math.Abs(zRow[j] / pivotElement)
+		}
+	}
+	return ratios
+}
 
-			if ratio < minRatio {
-				minRatio = ratio
-				pivotCol = j
-			}
+// findDualPivotColumn encuentra la columna pivote (variable que entra)
+// Simplex Dual: Mínimo cociente (Fila Z / Elemento Pivote), solo para elementos negativos en la Fila Pivote.
+func findDualPivotColumn(tableau models.SimplexTableau, pivotRow int) (int, error) {
+	minRatio := math.Inf(1)
+	pivotCol := -1
+
+	for j, ratio := range dualRatioTest(tableau, pivotRow) {
+		if ratio < minRatio {
+			minRatio = ratio
+			pivotCol = j
 		}
 	}
 
 	if pivotCol == -1 {
-		return -1, errors.New("problema infactible (infeasible)")
+		return -1, ErrInfeasible
 	}
 	return pivotCol, nil
 }
@@ -69,12 +82,12 @@ func findDualPivotColumn(tableau models.SimplexTableau, pivotRow int) (int, erro
 func SolveDualSimplexDetailed(objective []float64, constraints [][]float64, rhs []float64) models.SimplexResponse {
 	response := models.SimplexResponse{
 		Variables: make(map[string]float64),
-		Status:    "error: unknown",
+		Method:    "dual",
 	}
 
 	// 1. Validar entrada
 	if err := ValidarEntrada(objective, constraints, rhs); err != nil {
-		response.Status = "error: " + err.Error()
+		setStatus(&response, models.StatusError, err)
 		return response
 	}
 
@@ -85,20 +98,10 @@ func SolveDualSimplexDetailed(objective []float64, constraints [][]float64, rhs
 
 	// 2. Construir la tabla inicial (es la misma lógica que Primal)
 	currentTableau := buildInitialTableau(objective, constraints, rhs)
+	basis := initialBasis(numVariables, numConstraints)
 
-	// --- Lógica de truncamiento en línea para la Tabla Inicial ---
-	// Guardar una copia truncada para la visualización (Tabla 0)
-	truncatedInitial := copyTableau(currentTableau)
-	for i, row := range truncatedInitial {
-		for j, val := range row {
-			truncatedInitial[i][j] = math.Trunc(val*100) / 100
-		}
-	}
-	// Guardar la tabla inicial ENCAPSULADA
-	response.TableauxHistory = append(response.TableauxHistory, models.TableauStep{
-		Headers: headers,
-		Matrix:  truncatedInitial,
-	})
+	// Guardar la tabla inicial (Tabla 0) truncada para la visualización
+	response.TableauxHistory = append(response.TableauxHistory, newTableauStep(headers, currentTableau, basis))
 
 	numCols := len(currentTableau[0])
 	rhsCol := numCols - 1
@@ -107,41 +110,41 @@ func SolveDualSimplexDetailed(objective []float64, constraints [][]float64, rhs
 		// 3. Encontrar fila pivote (Dual: RHS más negativo)
 		pivotRow, err := findDualPivotRow(currentTableau)
 		if err != nil {
-			if strings.Contains(err.Error(), "factible") {
-				response.Status = "optimal" // Óptimo y Factible (solución encontrada)
+			if errors.Is(err, ErrFeasibleReached) {
+				setStatus(&response, models.StatusOptimal, nil) // Óptimo y Factible (solución encontrada)
 				break
 			}
-			response.Status = "error: " + err.Error()
+			setStatus(&response, models.StatusError, err)
 			return response
 		}
 
 		// 4. Encontrar columna pivote (Dual: Cociente Mínimo Z/|Pivot|)
+		lastStep := &response.TableauxHistory[len(response.TableauxHistory)-1]
+		ratios := dualRatioTest(currentTableau, pivotRow)
+		lastStep.ColumnRatios = truncatedRatios(ratios)
 		pivotCol, err := findDualPivotColumn(currentTableau, pivotRow)
 		if err != nil {
-			response.Status = "infeasible" // Infactibilidad detectada
+			lastStep.Leaving = headers[basis[pivotRow-1]]
+			lastStep.PivotRow = pivotRow
+			setStatus(&response, models.StatusInfeasible, nil) // Infactibilidad detectada
 			return response
 		}
+		recordPivot(lastStep, currentTableau, basis, pivotRow, pivotCol)
+		markDegeneratePivot(&response, lastStep, ratios[pivotCol])
 
 		// 5. Pivoteo
 		currentTableau = pivot(currentTableau, pivotRow, pivotCol)
-		// --- Lógica de truncamiento en línea para las Tablas Intermedias ---
-		// Guardar una copia truncada para la visualización
-		truncatedStep := copyTableau(currentTableau)
-		for i, row := range truncatedStep {
-			for j, val := range row {
-				truncatedStep[i][j] = math.Trunc(val*100) / 100
-			}
-		}
-		// Guardar el resultado del pivoteo ENCAPSULADO
-		response.TableauxHistory = append(response.TableauxHistory, models.TableauStep{
-			Headers: headers,
-			Matrix:  truncatedStep,
-		})
+		basis[pivotRow-1] = pivotCol
+
+		// Guardar el resultado del pivoteo truncado para la visualización
+		response.TableauxHistory = append(response.TableauxHistory, newTableauStep(headers, currentTableau, basis))
 	}
 
-	if response.Status != "optimal" {
+	if response.State != models.StatusOptimal {
+		setStatus(&response, models.StatusIterationLimit, nil)
 		return response
 	}
+	markSolutionFlags(&response, headers, currentTableau, basis)
 
 	// 6. Extracción de resultados
 	// En Dual, la tabla final ya está en estado óptimo/factible, y el valor
@@ -0,0 +1,180 @@
+package logic
+
+import (
+	"fmt"
+	"math"
+
+	"proyecto/simplex/i18n"
+	"proyecto/simplex/models"
+)
+
+// BuildDual construye el dual del modelo: una variable por restricción (y1, y2...),
+// una restricción por variable del primal y la matriz transpuesta. Admite igualdades,
+// variables libres y cotas (las cotas se dualizan como filas adicionales). Con solve
+// resuelve ambos problemas y verifica dualidad fuerte y holgura complementaria.
+func BuildDual(req models.SimplexRequest, solve bool) (models.DualResponse, error) {
+	if errs := validateModel(req, true); len(errs) > 0 {
+		return models.DualResponse{}, errs
+	}
+
+	primal := generalForm(req)
+	dual := dualOf(primal)
+	response := models.DualResponse{Dual: dual.request(), VariableSigns: dual.signs}
+	if solve {
+		check := checkDuality(primal, dual, req.Language)
+		response.Check = &check
+	}
+	return response, nil
+}
+
+// canonicalType es el sentido "natural" de las restricciones (<= al maximizar y >=
+// al minimizar): su variable dual es no negativa
+func canonicalType(maximize bool) string {
+	if maximize {
+		return "le"
+	}
+	return "ge"
+}
+
+// dualOf arma el dual según la tabla de correspondencias:
+//   - restricción canónica -> variable dual >= 0, opuesta -> <= 0, igualdad -> libre
+//   - variable >= 0 -> restricción dual canónica, <= 0 -> opuesta, libre -> igualdad
+func dualOf(p linearProgram) linearProgram {
+	d := linearProgram{
+		maximize:  !p.maximize,
+		objective: p.rhs,
+		rhs:       p.objective,
+		rows:      p.variables,
+	}
+
+	for i, t := range p.types {
+		d.variables = append(d.variables, fmt.Sprintf("y%d", i+1))
+		switch t {
+		case canonicalType(p.maximize):
+			d.signs = append(d.signs, models.SignNonNegative)
+		case "eq":
+			d.signs = append(d.signs, models.SignFree)
+		default:
+			d.signs = append(d.signs, models.SignNonPositive)
+		}
+	}
+
+	for j, s := range p.signs {
+		column := make([]float64, len(p.constraints))
+		for i, row := range p.constraints {
+			column[i] = row[j]
+		}
+		d.constraints = append(d.constraints, column)
+
+		switch s {
+		case models.SignNonNegative:
+			d.types = append(d.types, canonicalType(d.maximize))
+		case models.SignNonPositive:
+			d.types = append(d.types, canonicalType(p.maximize))
+		default:
+			d.types = append(d.types, "eq")
+		}
+	}
+	return d
+}
+
+// checkDuality resuelve el primal y el dual y verifica las condiciones de dualidad:
+// estados compatibles (ambos óptimos, uno ilimitado y el otro infactible o ambos
+// infactibles), igualdad de los valores óptimos y holgura complementaria
+func checkDuality(primal, dual linearProgram, lang string) models.DualityCheck {
+	primalState, primalZ, x := solveLP(primal)
+	dualState, dualZ, y := solveLP(dual)
+
+	check := models.DualityCheck{
+		Primal: lpSolution(primal, primalState, primalZ, x),
+		Dual:   lpSolution(dual, dualState, dualZ, y),
+	}
+
+	bothOptimal := primalState == models.StatusOptimal && dualState == models.StatusOptimal
+	consistent := bothOptimal ||
+		(primalState == models.StatusUnbounded && dualState == models.StatusInfeasible) ||
+		(primalState == models.StatusInfeasible && dualState == models.StatusUnbounded) ||
+		(primalState == models.StatusInfeasible && dualState == models.StatusInfeasible)
+	if !consistent {
+		check.Violations = append(check.Violations, models.DualityViolation{
+			Code:    "status_mismatch",
+			Message: i18n.Message(lang, i18n.DualityStatusMismatch, primalState, dualState),
+		})
+	}
+
+	if bothOptimal {
+		gap := truncate(primalZ-dualZ) + 0
+		check.DualityGap = &gap
+		check.StrongDuality = math.Abs(primalZ-dualZ) <= tolerance(primalZ, dualZ)
+		if !check.StrongDuality {
+			check.Violations = append(check.Violations, models.DualityViolation{
+				Code:    "strong_duality",
+				Value:   gap,
+				Message: i18n.Message(lang, i18n.DualityStrongViolated, formatValue(truncate(primalZ)), formatValue(truncate(dualZ))),
+			})
+		}
+
+		slackness := slacknessViolations(primal, dual, x, y, lang)
+		check.ComplementarySlackness = len(slackness) == 0
+		check.Violations = append(check.Violations, slackness...)
+	}
+
+	switch {
+	case len(check.Violations) > 0:
+		check.Message = i18n.Message(lang, i18n.DualityViolated, len(check.Violations))
+	case bothOptimal:
+		check.Message = i18n.Message(lang, i18n.DualityHolds)
+	case primalState == dualState:
+		check.Message = i18n.Message(lang, i18n.DualityBothInfeasible)
+	default:
+		check.Message = i18n.Message(lang, i18n.DualityUnboundedInfeasible)
+	}
+	return check
+}
+
+// slacknessViolations verifica la holgura complementaria: cada variable dual por la
+// holgura de su restricción primal y cada variable primal por la holgura de su
+// restricción dual deben valer 0
+func slacknessViolations(primal, dual linearProgram, x, y []float64, lang string) []models.DualityViolation {
+	var violations []models.DualityViolation
+	for i, row := range primal.constraints {
+		slack := primal.rhs[i] - dot(row, x)
+		if product := y[i] * slack; math.Abs(product) > tolerance(primal.rhs[i], y[i]) {
+			violations = append(violations, models.DualityViolation{
+				Code:       "complementary_slackness",
+				Constraint: primal.rows[i],
+				Variable:   dual.variables[i],
+				Value:      truncate(product) + 0,
+				Message: i18n.Message(lang, i18n.DualitySlackRow, primal.rows[i], formatValue(truncate(slack)),
+					dual.variables[i], formatValue(truncate(y[i]))),
+			})
+		}
+	}
+	for j, row := range dual.constraints {
+		slack := dot(row, y) - dual.rhs[j]
+		if product := x[j] * slack; math.Abs(product) > tolerance(dual.rhs[j], x[j]) {
+			violations = append(violations, models.DualityViolation{
+				Code:     "complementary_slackness",
+				Variable: primal.variables[j],
+				Value:    truncate(product) + 0,
+				Message:  i18n.Message(lang, i18n.DualitySlackVariable, primal.variables[j], formatValue(truncate(x[j])), formatValue(truncate(slack))),
+			})
+		}
+	}
+	return violations
+}
+
+// lpSolution arma la solución informada (valores truncados como en el resto de la API)
+func lpSolution(p linearProgram, state models.Status, z float64, values []float64) models.LPSolution {
+	solution := models.LPSolution{Status: state}
+	if state != models.StatusOptimal {
+		return solution
+	}
+	optimal := truncate(z) + 0
+	solution.Optimal = &optimal
+	solution.Variables = make(map[string]float64, len(values))
+	for j, v := range values {
+		solution.Variables[p.variables[j]] = truncate(v) + 0
+	}
+	return solution
+}
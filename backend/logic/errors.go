@@ -0,0 +1,20 @@
+package logic
+
+import "proyecto/simplex/i18n"
+
+// Errores del solver. Son errores del catálogo (i18n.Error): el mensaje se traduce al
+// responder y errors.Is compara por código, no por el texto.
+var (
+	// Condiciones de parada del Simplex primal y dual
+	ErrOptimalReached  = i18n.New(i18n.OptimalReached)
+	ErrFeasibleReached = i18n.New(i18n.FeasibleReached)
+	ErrUnbounded       = i18n.New(i18n.Unbounded)
+	ErrInfeasible      = i18n.New(i18n.Infeasible)
+
+	// Modelos no soportados
+	ErrInvalidType         = i18n.New(i18n.InvalidType)
+	ErrEqualityUnsupported = i18n.New(i18n.EqualityUnsupported)
+
+	// Modo interactivo
+	ErrNothingToUndo = i18n.New(i18n.SessionNothingToUndo)
+)
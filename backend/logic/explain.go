@@ -0,0 +1,96 @@
+package logic
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"proyecto/simplex/i18n"
+	"proyecto/simplex/models"
+)
+
+// explainSteps completa la explicación de cada tabla del historial a partir de las
+// decisiones de pivoteo registradas por el solver (variable entrante, saliente y cocientes)
+func explainSteps(result *models.SimplexResponse, lang string) {
+	optimal := result.State == models.StatusOptimal
+
+	for s := range result.TableauxHistory {
+		step := &result.TableauxHistory[s]
+		if result.Method == "dual" {
+			step.Explanation = explainDualStep(step, lang, optimal)
+		} else {
+			step.Explanation = explainPrimalStep(step, lang, optimal)
+		}
+		if step.DegeneratePivot {
+			step.Explanation += " " + i18n.Message(lang, i18n.ExplainDegenerate)
+		}
+	}
+}
+
+// explainPrimalStep explica la elección de findPivotColumn (coeficiente más negativo de Z)
+// y de findPivotRow (prueba del cociente mínimo)
+func explainPrimalStep(step *models.TableauStep, lang string, optimal bool) string {
+	if step.Entering == "" {
+		if optimal {
+			return i18n.Message(lang, i18n.ExplainPrimalOptimal, formatValue(step.Objective))
+		}
+		return ""
+	}
+
+	rhsCol := len(step.Headers) - 1
+	parts := []string{i18n.Message(lang, i18n.ExplainPrimalEntering, step.Entering, formatValue(step.Matrix[Z_ROW_INDEX][step.PivotCol]))}
+	if step.Leaving == "" {
+		return parts[0] + " " + i18n.Message(lang, i18n.ExplainPrimalUnbounded, step.Entering)
+	}
+
+	var ratios []string
+	for i := 1; i < len(step.Matrix); i++ {
+		ratio := "∞"
+		if i < len(step.Ratios) && step.Ratios[i] != nil {
+			ratio = formatValue(*step.Ratios[i])
+		}
+		ratios = append(ratios, fmt.Sprintf("%s/%s = %s",
+			formatValue(step.Matrix[i][rhsCol]), formatValue(step.Matrix[i][step.PivotCol]), ratio))
+	}
+	parts = append(parts,
+		i18n.Message(lang, i18n.ExplainPrimalRatios, strings.Join(ratios, ", "), step.Leaving, formatValue(*step.Ratios[step.PivotRow])),
+		i18n.Message(lang, i18n.ExplainPivot, formatValue(step.PivotValue)))
+	return strings.Join(parts, " ")
+}
+
+// explainDualStep explica la elección de findDualPivotRow (término independiente más
+// negativo) y de findDualPivotColumn (cociente mínimo |Z / fila pivote|)
+func explainDualStep(step *models.TableauStep, lang string, optimal bool) string {
+	if step.Leaving == "" {
+		if optimal {
+			return i18n.Message(lang, i18n.ExplainDualOptimal, formatValue(step.Objective))
+		}
+		return ""
+	}
+
+	rhsCol := len(step.Headers) - 1
+	parts := []string{i18n.Message(lang, i18n.ExplainDualLeaving, step.Leaving, formatValue(step.Matrix[step.PivotRow][rhsCol]))}
+	if step.Entering == "" {
+		return parts[0] + " " + i18n.Message(lang, i18n.ExplainDualInfeasible, step.Leaving)
+	}
+
+	var ratios []string
+	for j, ratio := range step.ColumnRatios {
+		if ratio == nil {
+			continue
+		}
+		ratios = append(ratios, fmt.Sprintf("%s: |%s/%s| = %s", step.Headers[j],
+			formatValue(step.Matrix[Z_ROW_INDEX][j]), formatValue(step.Matrix[step.PivotRow][j]), formatValue(*ratio)))
+	}
+	parts = append(parts,
+		i18n.Message(lang, i18n.ExplainDualRatios, strings.Join(ratios, ", "), step.Entering, formatValue(*step.ColumnRatios[step.PivotCol])),
+		i18n.Message(lang, i18n.ExplainPivot, formatValue(step.PivotValue)))
+	return strings.Join(parts, " ")
+}
+
+func formatValue(v float64) string {
+	if v == 0 {
+		v = 0 // evita "-0"
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
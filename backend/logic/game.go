@@ -0,0 +1,206 @@
+package logic
+
+import (
+	"fmt"
+	"math"
+	"slices"
+
+	"proyecto/simplex/i18n"
+	"proyecto/simplex/models"
+)
+
+// SolveGame resuelve un juego de suma cero entre dos jugadores. Primero busca un
+// punto de silla (maximin igual a minimax), que da estrategias puras. Si no hay,
+// elimina las estrategias dominadas, suma una constante a los pagos para que sean
+// positivos y resuelve con el simplex un programa lineal por jugador:
+//
+//	jugador columna: max Σ y_j  sujeto a  Σ_j a_ij y_j ≤ 1  (primal)
+//	jugador fila:    min Σ x_i  sujeto a  Σ_i a_ij x_i ≥ 1  (dual)
+//
+// El valor del juego desplazado es 1/Σ y = 1/Σ x y las estrategias óptimas son y y x
+// multiplicadas por ese valor.
+func SolveGame(req models.GameRequest) (models.GameResponse, error) {
+	if err := validateGame(req); err != nil {
+		return models.GameResponse{}, err
+	}
+	lang := req.Language
+	a := req.Payoffs
+	m, n := len(a), len(a[0])
+
+	response := models.GameResponse{
+		Status:         models.StatusOptimal,
+		RowStrategy:    make([]float64, m),
+		ColumnStrategy: make([]float64, n),
+	}
+	for i := range m {
+		response.Rows = append(response.Rows, transportName(req.RowNames, "A", i))
+	}
+	for j := range n {
+		response.Columns = append(response.Columns, transportName(req.ColumnNames, "B", j))
+	}
+
+	// Punto de silla: el mínimo de su fila y el máximo de su columna
+	rowMin, colMax := make([]float64, m), make([]float64, n)
+	for i := range m {
+		rowMin[i] = slices.Min(a[i])
+	}
+	for j := range n {
+		colMax[j] = math.Inf(-1)
+		for i := range m {
+			colMax[j] = math.Max(colMax[j], a[i][j])
+		}
+	}
+	response.Maximin, response.Minimax = slices.Max(rowMin), slices.Min(colMax)
+	for i := range m {
+		for j := range n {
+			if a[i][j] == rowMin[i] && a[i][j] == colMax[j] {
+				response.SaddlePoints = append(response.SaddlePoints, models.GameCell{Row: i, Col: j})
+			}
+		}
+	}
+	if len(response.SaddlePoints) > 0 {
+		saddle := response.SaddlePoints[0]
+		response.Value = truncate(a[saddle.Row][saddle.Col]) + 0
+		response.RowStrategy[saddle.Row], response.ColumnStrategy[saddle.Col] = 1, 1
+		response.Message = i18n.Message(lang, i18n.GameSaddlePoint, response.Rows[saddle.Row], response.Columns[saddle.Col], formatValue(response.Value))
+		return response, nil
+	}
+
+	// Estrategias dominadas: una fila con pagos menores o iguales que otra en todas las
+	// columnas que quedan, o una columna con pagos mayores o iguales que otra
+	rows, cols := make([]int, m), make([]int, n)
+	for i := range rows {
+		rows[i] = i
+	}
+	for j := range cols {
+		cols[j] = j
+	}
+	for {
+		if i, k, ok := dominatedStrategy(rows, cols, func(k, i, j int) bool { return a[k][j] >= a[i][j] }); ok {
+			rows = slices.DeleteFunc(rows, func(r int) bool { return r == i })
+			response.Dominances = append(response.Dominances, models.Dominance{
+				Player: models.PlayerRow, Removed: i, By: k,
+				Description: i18n.Message(lang, i18n.GameRowDominated, response.Rows[i], response.Rows[k]),
+			})
+			continue
+		}
+		if j, l, ok := dominatedStrategy(cols, rows, func(l, j, i int) bool { return a[i][l] <= a[i][j] }); ok {
+			cols = slices.DeleteFunc(cols, func(c int) bool { return c == j })
+			response.Dominances = append(response.Dominances, models.Dominance{
+				Player: models.PlayerColumn, Removed: j, By: l,
+				Description: i18n.Message(lang, i18n.GameColumnDominated, response.Columns[j], response.Columns[l]),
+			})
+			continue
+		}
+		break
+	}
+	response.ReducedRows, response.ReducedColumns = rows, cols
+
+	// Desplazamiento para que todos los pagos sean positivos (el valor del juego
+	// desplazado también lo es y los programas lineales quedan acotados)
+	lowest := math.Inf(1)
+	for _, i := range rows {
+		for _, j := range cols {
+			lowest = math.Min(lowest, a[i][j])
+		}
+	}
+	if lowest <= 0 {
+		response.Shift = 1 - lowest
+	}
+	shifted := func(i, j int) float64 { return a[i][j] + response.Shift }
+
+	columnLP := gameModel(len(cols), len(rows), "y", "max", "le", lang, func(r, c int) float64 { return shifted(rows[r], cols[c]) })
+	rowLP := gameModel(len(rows), len(cols), "x", "min", "ge", lang, func(r, c int) float64 { return shifted(rows[c], cols[r]) })
+	for _, lp := range []*models.GameLP{&columnLP, &rowLP} {
+		solution, err := SolveRequest(lp.Model)
+		if err != nil {
+			return models.GameResponse{}, err
+		}
+		lp.Solution = solution
+		if solution.State != models.StatusOptimal {
+			response.Status, response.Message = solution.State, solution.Message
+			return response, nil
+		}
+	}
+	response.ColumnLP, response.RowLP = &columnLP, &rowLP
+
+	y := lpValues(columnLP.Solution, len(cols))
+	x := lpValues(rowLP.Solution, len(rows))
+	value := 1 / sum(y)
+	for k, j := range cols {
+		response.ColumnStrategy[j] = roundValue(y[k] * value)
+	}
+	for k, i := range rows {
+		response.RowStrategy[i] = roundValue(x[k] * value)
+	}
+	response.Value = roundValue(value - response.Shift)
+	response.Message = i18n.Message(lang, i18n.GameMixed, formatValue(response.Value))
+	return response, nil
+}
+
+// validateGame verifica que la matriz de pagos sea rectangular, no vacía y finita
+func validateGame(req models.GameRequest) error {
+	var errs ValidationErrors
+	if len(req.Payoffs) == 0 || len(req.Payoffs[0]) == 0 {
+		errs = append(errs, newFieldError("payoffs", i18n.GameEmptyPayoffs))
+	}
+	for i, row := range req.Payoffs {
+		if len(row) != len(req.Payoffs[0]) {
+			fe := newFieldError(fmt.Sprintf("payoffs[%d]", i), i18n.GameRaggedPayoffs)
+			fe.Details = map[string]any{"expected": len(req.Payoffs[0]), "got": len(row)}
+			errs = append(errs, fe)
+		}
+		for j, v := range row {
+			if !isFinite(v) {
+				errs = append(errs, nonFinite(fmt.Sprintf("payoffs[%d][%d]", i, j), i18n.GameNonFinitePayoff, v))
+			}
+		}
+	}
+	return errs.orNil()
+}
+
+// dominatedStrategy busca una estrategia de candidates dominada por otra: better(k,
+// i, o) indica si k es al menos tan buena como i frente a la estrategia o del rival
+func dominatedStrategy(candidates, others []int, better func(k, i, o int) bool) (int, int, bool) {
+	for _, i := range candidates {
+		for _, k := range candidates {
+			if k != i && !slices.ContainsFunc(others, func(o int) bool { return !better(k, i, o) }) {
+				return i, k, true
+			}
+		}
+	}
+	return -1, -1, false
+}
+
+// gameModel arma el programa lineal de un jugador: una variable por estrategia
+// propia, objetivo Σ de las variables y una restricción por estrategia del rival
+func gameModel(variables, constraints int, prefix, objectiveType, constraintType, lang string, coef func(r, c int) float64) models.GameLP {
+	model := models.SimplexRequest{Type: objectiveType, Language: lang}
+	for c := range variables {
+		model.Objective = append(model.Objective, 1)
+		model.VariableNames = append(model.VariableNames, fmt.Sprintf("%s%d", prefix, c+1))
+	}
+	for r := range constraints {
+		row := make([]float64, variables)
+		for c := range row {
+			row[c] = coef(r, c)
+		}
+		model.Constraints = append(model.Constraints, row)
+		model.RHS = append(model.RHS, 1)
+		model.ConstraintTypes = append(model.ConstraintTypes, constraintType)
+	}
+	return models.GameLP{Model: model}
+}
+
+// lpValues lee de la tabla óptima sin truncar el valor de las primeras variables de
+// decisión (las no básicas valen 0)
+func lpValues(result models.SimplexResponse, variables int) []float64 {
+	values := make([]float64, variables)
+	rhsCol := len(result.FinalTableau[0]) - 1
+	for i, col := range result.FinalBasis {
+		if col >= 1 && col <= variables {
+			values[col-1] = result.FinalTableau[i+1][rhsCol]
+		}
+	}
+	return values
+}
@@ -0,0 +1,247 @@
+package logic
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strings"
+
+	"proyecto/simplex/i18n"
+	"proyecto/simplex/models"
+)
+
+// SolveGoals resuelve un modelo de programación por metas. Cada meta a·x (≥, ≤ o =) t se
+// convierte en a·x + d- - d+ = t con sus dos desviaciones y las igualdades se
+// parten en un par le/ge (el tableau no admite eq). Con el método ponderado se
+// minimiza una vez la suma ponderada de las desviaciones penalizadas; con el
+// lexicográfico se resuelve un nivel de prioridad por vez y la desviación lograda
+// se agrega como restricción antes de pasar al siguiente.
+func SolveGoals(req models.GoalRequest) (models.GoalResponse, error) {
+	if err := validateGoals(req); err != nil {
+		return models.GoalResponse{}, err
+	}
+	lang := req.Language
+	response := models.GoalResponse{Method: req.Method, Status: models.StatusOptimal}
+	if response.Method == "" {
+		response.Method = models.GoalWeighted
+	}
+
+	n := goalVariables(req)
+	width := n + 2*len(req.Goals)
+	base := models.SimplexRequest{Type: "min", Language: lang}
+	for j := range n {
+		base.VariableNames = append(base.VariableNames, variableName(req.VariableNames, j))
+	}
+	for k := range req.Goals {
+		base.VariableNames = append(base.VariableNames, fmt.Sprintf("d%d-", k+1), fmt.Sprintf("d%d+", k+1))
+	}
+	addRow := func(row []float64, rhs float64, kind string) {
+		kinds := []string{kind}
+		if kind == "eq" {
+			kinds = []string{"le", "ge"}
+		}
+		for _, t := range kinds {
+			base.Constraints = append(base.Constraints, row)
+			base.RHS = append(base.RHS, rhs)
+			base.ConstraintTypes = append(base.ConstraintTypes, t)
+		}
+	}
+	for i, coefs := range req.Constraints {
+		row := make([]float64, width)
+		copy(row, coefs)
+		addRow(row, req.RHS[i], req.ConstraintTypes[i])
+	}
+	for k, goal := range req.Goals {
+		row := make([]float64, width)
+		copy(row, goal.Coefficients)
+		row[n+2*k], row[n+2*k+1] = 1, -1
+		addRow(row, goal.Target, "eq")
+	}
+
+	// Niveles: todas las metas juntas (ponderado) o agrupadas por prioridad
+	levels := map[int][]int{}
+	for k, goal := range req.Goals {
+		priority := 0
+		if response.Method == models.GoalLexicographic {
+			priority = max(goal.Priority, 1)
+		}
+		levels[priority] = append(levels[priority], k)
+	}
+
+	var values []float64
+	for _, priority := range slices.Sorted(maps.Keys(levels)) {
+		stage := models.GoalStage{Priority: priority}
+		model := base
+		model.Constraints = slices.Clone(base.Constraints)
+		model.Objective = make([]float64, width)
+		for _, k := range levels[priority] {
+			goal := req.Goals[k]
+			weight := 1.0
+			if goal.Weight != nil {
+				weight = *goal.Weight
+			}
+			if goal.Type != "le" {
+				model.Objective[n+2*k] = weight
+			}
+			if goal.Type != "ge" {
+				model.Objective[n+2*k+1] = weight
+			}
+			stage.Goals = append(stage.Goals, goalName(goal, k))
+		}
+		stage.Model = model
+		stage.Solution = solveGoalStage(model)
+		response.Stages = append(response.Stages, stage)
+		if stage.Solution.State != models.StatusOptimal {
+			response.Status, response.Message = stage.Solution.State, stage.Solution.Message
+			return response, nil
+		}
+
+		// Los niveles siguientes no pueden empeorar la desviación lograda (con un margen
+		// mínimo para que el error de redondeo no los vuelva infactibles)
+		values = lpValues(stage.Solution, width)
+		deviation := dot(model.Objective, values)
+		response.Stages[len(response.Stages)-1].Deviation = roundValue(deviation)
+		addRow(model.Objective, deviation+1e-9*math.Max(1, math.Abs(deviation)), "le")
+	}
+
+	response.Variables = make(map[string]float64, n)
+	for j := range n {
+		response.Variables[base.VariableNames[j]] = roundValue(values[j])
+	}
+	achieved := 0
+	for k, goal := range req.Goals {
+		under, over := values[n+2*k], values[n+2*k+1]
+		result := models.GoalAchievement{
+			Name:   goalName(goal, k),
+			Target: goal.Target,
+			Value:  roundValue(dot(goal.Coefficients, values[:n])),
+			Under:  roundValue(under),
+			Over:   roundValue(over),
+		}
+		tol := tolerance(goal.Target)
+		result.Achieved = (goal.Type == "le" || under <= tol) && (goal.Type == "ge" || over <= tol)
+		if result.Achieved {
+			achieved++
+		}
+		response.Goals = append(response.Goals, result)
+	}
+
+	var deviations []string
+	for _, stage := range response.Stages {
+		deviations = append(deviations, formatValue(stage.Deviation))
+	}
+	response.Message = i18n.Message(lang, i18n.GoalSummary, achieved, len(req.Goals), strings.Join(deviations, ", "))
+	return response, nil
+}
+
+// solveGoalStage resuelve un nivel con el simplex dual: el objetivo solo tiene pesos
+// no negativos, así que la tabla inicial ya es dual factible y el dual simplex
+// arranca aunque las metas tengan RHS negativos después de estandarizar
+func solveGoalStage(model models.SimplexRequest) models.SimplexResponse {
+	constraints, rhs, err := StandardizeConstraints(model.Constraints, model.RHS, model.ConstraintTypes)
+	if err != nil {
+		return errorResponse(err)
+	}
+	result := SolveDualSimplexDetailed(model.Objective, constraints, rhs)
+	result.Optimal = truncate(result.Optimal) + 0
+	renameVariables(&result, model.VariableNames)
+	result.Message = statusMessage(result, model.Language)
+	return result
+}
+
+// validateGoals verifica las metas, las restricciones duras y el método, y devuelve
+// todos los problemas encontrados
+func validateGoals(req models.GoalRequest) error {
+	var errs ValidationErrors
+	if len(req.Goals) == 0 {
+		errs = append(errs, newFieldError("goals", i18n.GoalEmpty))
+	}
+	n := goalVariables(req)
+	columns := func(field string, got int) {
+		if got != n || n == 0 {
+			fe := newFieldError(field, i18n.GoalColumns, n)
+			fe.Details = map[string]any{"expected": n, "got": got}
+			errs = append(errs, fe)
+		}
+	}
+
+	for k, goal := range req.Goals {
+		field := fmt.Sprintf("goals[%d]", k)
+		columns(field+".coefficients", len(goal.Coefficients))
+		for j, v := range goal.Coefficients {
+			if !isFinite(v) {
+				errs = append(errs, nonFinite(fmt.Sprintf("%s.coefficients[%d]", field, j), i18n.GoalNonFinite, v))
+			}
+		}
+		if !isFinite(goal.Target) {
+			errs = append(errs, nonFinite(field+".target", i18n.GoalNonFinite, goal.Target))
+		}
+		if goal.Type != "le" && goal.Type != "ge" && goal.Type != "eq" {
+			errs = append(errs, newFieldError(field+".type", i18n.GoalType, goal.Type))
+		}
+		if w := goal.Weight; w != nil && (!isFinite(*w) || *w < 0) {
+			fe := newFieldError(field+".weight", i18n.GoalWeight)
+			fe.Details = map[string]any{"value": fmt.Sprint(*w)}
+			errs = append(errs, fe)
+		}
+		if goal.Priority < 0 {
+			fe := newFieldError(field+".priority", i18n.GoalPriority)
+			fe.Details = map[string]any{"value": goal.Priority}
+			errs = append(errs, fe)
+		}
+	}
+
+	if len(req.RHS) != len(req.Constraints) {
+		fe := newFieldError("rhs", i18n.RHSLength)
+		fe.Details = map[string]any{"expected": len(req.Constraints), "got": len(req.RHS)}
+		errs = append(errs, fe)
+	}
+	if len(req.ConstraintTypes) != len(req.Constraints) {
+		fe := newFieldError("constraint_types", i18n.ConstraintSizes)
+		fe.Details = map[string]any{"expected": len(req.Constraints), "got": len(req.ConstraintTypes)}
+		errs = append(errs, fe)
+	}
+	for i, row := range req.Constraints {
+		columns(fmt.Sprintf("constraints[%d]", i), len(row))
+		for j, v := range row {
+			if !isFinite(v) {
+				errs = append(errs, nonFinite(fmt.Sprintf("constraints[%d][%d]", i, j), i18n.NonFiniteConstraints, v))
+			}
+		}
+	}
+	for i, v := range req.RHS {
+		if !isFinite(v) {
+			errs = append(errs, nonFinite(fmt.Sprintf("rhs[%d]", i), i18n.NonFiniteRHS, v))
+		}
+	}
+	for i, t := range req.ConstraintTypes {
+		if t != "le" && t != "ge" && t != "eq" {
+			errs = append(errs, newFieldError(fmt.Sprintf("constraint_types[%d]", i), i18n.UnknownConstraint, t))
+		}
+	}
+
+	if req.Method != "" && req.Method != models.GoalWeighted && req.Method != models.GoalLexicographic {
+		errs = append(errs, newFieldError("method", i18n.GoalUnknownMethod, req.Method))
+	}
+	return errs.orNil()
+}
+
+// goalVariables es la cantidad de variables de decisión: una por nombre o, si no hay
+// nombres, una por coeficiente de la primera meta
+func goalVariables(req models.GoalRequest) int {
+	if len(req.VariableNames) > 0 {
+		return len(req.VariableNames)
+	}
+	if len(req.Goals) > 0 {
+		return len(req.Goals[0].Coefficients)
+	}
+	return 0
+}
+
+func goalName(goal models.Goal, k int) string {
+	if goal.Name != "" {
+		return goal.Name
+	}
+	return fmt.Sprintf("G%d", k+1)
+}
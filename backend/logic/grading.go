@@ -0,0 +1,356 @@
+package logic
+
+import (
+	"math"
+	"slices"
+
+	"proyecto/simplex/i18n"
+	"proyecto/simplex/models"
+)
+
+// defaultGradeTolerance admite las diferencias del truncamiento a 2 decimales: las
+// celdas del alumno se comparan con la tabla exacta, no con otra tabla truncada
+const defaultGradeTolerance = 0.01
+
+// GradeTableaux corrige la secuencia de tablas de un alumno: la tabla inicial, en
+// cada iteración si el pivote es válido para el método (con las mismas reglas que el
+// modo interactivo) y si las operaciones de fila son correctas, y si la tabla final
+// resuelve el problema. Cada iteración se corrige a partir de la base anterior del
+// alumno, así un error no se arrastra a las siguientes: la tabla esperada es la
+// tabla exacta (sin truncar) de la nueva base y el pivote se evalúa en la tabla
+// exacta de la base anterior del alumno.
+func GradeTableaux(req models.GradeRequest) (models.GradeResponse, error) {
+	method, headers, initial, err := initialTableau(req.SimplexRequest)
+	if err != nil {
+		return models.GradeResponse{}, err
+	}
+	if len(req.Tableaux) == 0 {
+		return models.GradeResponse{}, ValidationErrors{newFieldError("tableaux", i18n.GradeNoTableaux)}
+	}
+	tol := req.Tolerance
+	if tol <= 0 {
+		tol = defaultGradeTolerance
+	}
+	lang := req.Language
+	rows, cols := len(initial.tableau), len(initial.tableau[0])
+
+	var response models.GradeResponse
+	var previous *basicTableau
+	for k, step := range req.Tableaux {
+		grade := models.StepGrade{Step: k}
+		if !hasShape(step.Matrix, rows, cols) {
+			grade.Message = i18n.Message(lang, i18n.GradeDimensions, rows, cols)
+			response.Steps = append(response.Steps, grade)
+			previous = nil
+			continue
+		}
+
+		basis, hasBasis := stepBasis(step, headers)
+		switch {
+		case k == 0:
+			if !hasBasis {
+				basis = initial.basis
+			}
+			grade.PivotValid, grade.MatchesRule = true, true
+			grade.Cells = diffCells(initial.tableau, step.Matrix, headers, tol)
+			grade.ArithmeticValid = len(grade.Cells) == 0
+			grade.Correct = grade.ArithmeticValid
+			if grade.Correct {
+				grade.Message = i18n.Message(lang, i18n.GradeStepCorrect)
+			} else {
+				grade.Message = i18n.Message(lang, i18n.GradeInitialWrong, len(grade.Cells))
+			}
+		case previous == nil:
+			grade.Message = i18n.Message(lang, i18n.GradePreviousInvalid)
+		default:
+			row, col, ok := stepPivot(req.Tableaux[k-1], *previous, basis, hasBasis, headers)
+			if !ok {
+				grade.Message = i18n.Message(lang, i18n.GradePivotUnknown)
+				break
+			}
+			if !hasBasis {
+				basis = replaceBasis(previous.basis, row, col)
+			}
+			// El pivote se juzga sobre la tabla exacta de la base anterior: los cocientes
+			// de la tabla truncada del alumno pueden cambiar el orden
+			exact := *previous
+			if tableau, ok := basisTableau(initial.tableau, previous.basis); ok {
+				exact.tableau = tableau
+			}
+			expected, _ := basisTableau(initial.tableau, replaceBasis(previous.basis, row, col))
+			gradePivot(&grade, method, headers, exact, expected, step.Matrix, row, col, tol, lang)
+		}
+
+		response.Steps = append(response.Steps, grade)
+		previous = &basicTableau{tableau: step.Matrix, basis: basis}
+	}
+
+	gradeFinal(&response, req, method, previous, tol)
+	return response, nil
+}
+
+// gradePivot corrige una iteración: validez del pivote en la tabla exacta de la base
+// anterior y operaciones de fila contra la tabla exacta esperada (nil si el pivote
+// es 0 y la nueva base no existe)
+func gradePivot(grade *models.StepGrade, method string, headers []string, previous basicTableau, expected, matrix models.SimplexTableau, row, col int, tol float64, lang string) {
+	choice := pivotChoice(headers, previous.basis, row, col)
+	grade.Pivot = &choice
+
+	reason := pivotError(method, headers, previous, row, col, lang)
+	grade.PivotValid = reason == ""
+	if state, ruleRow, ruleCol := nextPivot(method, previous.tableau); grade.PivotValid && state == "" {
+		grade.MatchesRule = matchesRule(method, previous.tableau, row, col, ruleRow, ruleCol)
+	}
+
+	if expected != nil {
+		grade.Cells = diffCells(expected, matrix, headers, tol)
+		grade.ArithmeticValid = len(grade.Cells) == 0
+	}
+	grade.Correct = grade.PivotValid && grade.ArithmeticValid
+
+	switch {
+	case !grade.PivotValid:
+		grade.Message = reason
+	case !grade.ArithmeticValid:
+		grade.Message = i18n.Message(lang, i18n.GradeCellsWrong, len(grade.Cells))
+	case !grade.MatchesRule:
+		grade.Message = i18n.Message(lang, i18n.GradeStepCorrect) + " " + i18n.Message(lang, i18n.GradeRuleDiffers)
+	default:
+		grade.Message = i18n.Message(lang, i18n.GradeStepCorrect)
+	}
+}
+
+// gradeFinal verifica que la última tabla sea final con el mismo estado y valor de Z
+// que el solver, marca el primer error y calcula la nota
+func gradeFinal(response *models.GradeResponse, req models.GradeRequest, method string, last *basicTableau, tol float64) {
+	lang := req.Language
+	expected, _ := SolveRequest(req.SimplexRequest)
+	response.ExpectedStatus = expected.State
+	if expected.State == models.StatusOptimal {
+		optimal := expected.Optimal
+		response.ExpectedObjective = &optimal
+	}
+
+	finalMessage := i18n.Message(lang, i18n.GradeFinalInvalid)
+	if last != nil {
+		state, _, _ := nextPivot(method, last.tableau)
+		response.FinalStatus = state
+		z := truncate(last.tableau[Z_ROW_INDEX][len(last.tableau[0])-1]) + 0
+		response.FinalObjective = &z
+
+		switch {
+		case state == "":
+			finalMessage = i18n.Message(lang, i18n.GradeFinalNotFinal)
+		case state != expected.State:
+			finalMessage = i18n.Message(lang, i18n.GradeFinalWrongStatus, state, expected.State)
+		case state == models.StatusOptimal && math.Abs(z-expected.Optimal) > tol:
+			finalMessage = i18n.Message(lang, i18n.GradeFinalWrongValue, formatValue(z), formatValue(expected.Optimal))
+		default:
+			response.FinalCorrect = true
+		}
+	}
+
+	correct := 0
+	for i := range response.Steps {
+		step := &response.Steps[i]
+		if step.Correct {
+			correct++
+		} else if response.FirstError == nil {
+			step.FirstError = true
+			response.FirstError = &step.Step
+		}
+	}
+	points := correct
+	if response.FinalCorrect {
+		points++
+	}
+	response.Score = truncate(float64(points) / float64(len(response.Steps)+1))
+	response.Passed = response.FirstError == nil && response.FinalCorrect
+
+	switch {
+	case response.Passed:
+		response.Message = i18n.Message(lang, i18n.GradePassed)
+		return
+	case response.FirstError != nil:
+		response.Message = i18n.Message(lang, i18n.GradeFailed, correct, len(response.Steps), *response.FirstError)
+	default:
+		response.Message = i18n.Message(lang, i18n.GradeStepsCorrect)
+	}
+	if !response.FinalCorrect {
+		response.Message += " " + finalMessage
+	}
+}
+
+// replaceBasis devuelve la base que resulta de pivotear en (row, col)
+func replaceBasis(basis []int, row, col int) []int {
+	next := slices.Clone(basis)
+	next[row-1] = col
+	return next
+}
+
+// basisTableau devuelve la tabla exacta de una base a partir de la tabla inicial:
+// Gauss-Jordan sobre las filas de restricción hasta que la columna basis[i] sea
+// unitaria en la fila i+1, y después la fila Z sin costos en las columnas básicas.
+// Devuelve false si las columnas de la base son linealmente dependientes.
+func basisTableau(initial models.SimplexTableau, basis []int) (models.SimplexTableau, bool) {
+	tableau := copyTableau(initial)
+	rows := tableau[1:]
+	for i, col := range basis {
+		best := i
+		for r := i + 1; r < len(rows); r++ {
+			if math.Abs(rows[r][col]) > math.Abs(rows[best][col]) {
+				best = r
+			}
+		}
+		if math.Abs(rows[best][col]) < 1e-9 {
+			return nil, false
+		}
+		rows[i], rows[best] = rows[best], rows[i]
+		pivotVal := rows[i][col]
+		for j := range rows[i] {
+			rows[i][j] /= pivotVal
+		}
+		for r := range rows {
+			if factor := rows[r][col]; r != i && factor != 0 {
+				for j := range rows[r] {
+					rows[r][j] -= factor * rows[i][j]
+				}
+			}
+		}
+	}
+
+	zRow := tableau[Z_ROW_INDEX]
+	for i, col := range basis {
+		if factor := zRow[col]; factor != 0 {
+			for j := range zRow {
+				zRow[j] -= factor * rows[i][j]
+			}
+		}
+	}
+	return tableau, true
+}
+
+func hasShape(matrix models.SimplexTableau, rows, cols int) bool {
+	if len(matrix) != rows {
+		return false
+	}
+	for _, row := range matrix {
+		if len(row) != cols {
+			return false
+		}
+	}
+	return true
+}
+
+// diffCells compara la tabla esperada (exacta) con la recibida celda por celda; el
+// valor esperado se informa truncado, como en el historial
+func diffCells(expected, got models.SimplexTableau, headers []string, tol float64) []models.CellError {
+	var cells []models.CellError
+	for i, row := range expected {
+		for j, want := range row {
+			if math.Abs(want-got[i][j]) > tol+1e-9 {
+				cells = append(cells, models.CellError{
+					Row:      i,
+					Col:      j,
+					Header:   headers[j],
+					Expected: truncate(want) + 0,
+					Got:      got[i][j],
+				})
+			}
+		}
+	}
+	return cells
+}
+
+// stepBasis obtiene la base de la tabla a partir de los nombres de Basis (la fila 0
+// es "Z") o, si faltan, de las columnas unitarias de la matriz
+func stepBasis(step models.TableauStep, headers []string) ([]int, bool) {
+	numRows := len(step.Matrix) - 1
+	if len(step.Basis) == numRows+1 {
+		basis := make([]int, numRows)
+		ok := true
+		for i, name := range step.Basis[1:] {
+			basis[i] = headerIndex(name, headers, step.Headers)
+			ok = ok && basis[i] > 0 && basis[i] < len(headers)-1
+		}
+		if ok {
+			return basis, true
+		}
+	}
+
+	basis := make([]int, numRows)
+	rhsCol := len(headers) - 1
+	for j := 1; j < rhsCol; j++ {
+		unitRow := -1
+		for i, row := range step.Matrix {
+			switch {
+			case math.Abs(row[j]) < 1e-9:
+			case math.Abs(row[j]-1) < 1e-9 && unitRow == -1 && i > 0:
+				unitRow = i
+			default:
+				unitRow = -2
+			}
+		}
+		if unitRow > 0 && basis[unitRow-1] == 0 {
+			basis[unitRow-1] = j
+		}
+	}
+	return basis, !slices.Contains(basis, 0)
+}
+
+// headerIndex busca la columna por nombre en los encabezados del modelo o, si el
+// alumno usó otros nombres, en los suyos (misma disposición de columnas)
+func headerIndex(name string, headers, stepHeaders []string) int {
+	if j := slices.Index(headers, name); j >= 0 {
+		return j
+	}
+	if len(stepHeaders) == len(headers) {
+		return slices.Index(stepHeaders, name)
+	}
+	return -1
+}
+
+// stepPivot deduce el pivote que lleva de la tabla anterior a la actual: el único
+// cambio de base entre ambas (lo que el alumno hizo) o, si no se puede deducir,
+// pivot_row y pivot_col o entering/leaving anotados en la tabla anterior
+func stepPivot(prevStep models.TableauStep, previous basicTableau, basis []int, hasBasis bool, headers []string) (int, int, bool) {
+	row, col, ok := basisChange(previous.basis, basis, hasBasis)
+	if !ok {
+		row, col, ok = annotatedPivot(prevStep, previous, headers)
+	}
+	if !ok || row < 1 || row > len(previous.basis) || col < 1 || col >= len(headers)-1 {
+		return 0, 0, false
+	}
+	return row, col, true
+}
+
+// basisChange devuelve la fila cuya variable básica cambió y la columna que entró
+func basisChange(previous, basis []int, hasBasis bool) (int, int, bool) {
+	if !hasBasis {
+		return 0, 0, false
+	}
+	row, col := 0, 0
+	for i := range basis {
+		if basis[i] != previous[i] {
+			if row != 0 {
+				return 0, 0, false
+			}
+			row, col = i+1, basis[i]
+		}
+	}
+	return row, col, row != 0
+}
+
+func annotatedPivot(prevStep models.TableauStep, previous basicTableau, headers []string) (int, int, bool) {
+	if prevStep.PivotRow > 0 && prevStep.PivotCol > 0 {
+		return prevStep.PivotRow, prevStep.PivotCol, true
+	}
+	if prevStep.Entering != "" && prevStep.Leaving != "" {
+		col := headerIndex(prevStep.Entering, headers, prevStep.Headers)
+		row := slices.Index(previous.basis, headerIndex(prevStep.Leaving, headers, prevStep.Headers)) + 1
+		if col > 0 && row > 0 {
+			return row, col, true
+		}
+	}
+	return 0, 0, false
+}
@@ -0,0 +1,405 @@
+package logic
+
+import (
+	"cmp"
+	"math"
+	"slices"
+
+	"proyecto/simplex/i18n"
+	"proyecto/simplex/models"
+)
+
+// maxGraphPlanes limita las restricciones del método gráfico (contando cotas y signos):
+// los vértices se buscan intersecando todos los pares o ternas
+const maxGraphPlanes = 40
+
+// FeasibleRegion calcula la región factible de un modelo de 2 o 3 variables para el
+// método gráfico: vértices (intersecciones factibles de las restricciones), caras en 3
+// variables, vértices óptimos y rectas de nivel de Z. Si el solver puede resolver el
+// modelo con tablas, también mapea cada tabla del historial al vértice que visita.
+// Admite igualdades y variables libres; la región no acotada se recorta con la vista.
+func FeasibleRegion(req models.SimplexRequest) (models.FeasibleRegion, error) {
+	if err := validateModel(req, true).orNil(); err != nil {
+		return models.FeasibleRegion{}, err
+	}
+	n := len(req.Objective)
+	if n != 2 && n != 3 {
+		return models.FeasibleRegion{}, newUnsupportedError("objective", i18n.New(i18n.GraphDimensions, n))
+	}
+
+	p := generalForm(req)
+	planes := regionPlanes(p)
+	if len(planes) > maxGraphPlanes {
+		return models.FeasibleRegion{}, newUnsupportedError("constraints", i18n.New(i18n.GraphTooLarge, len(planes), maxGraphPlanes))
+	}
+
+	region := models.FeasibleRegion{
+		Dimension:   n,
+		Variables:   p.variables,
+		Objective:   p.objective,
+		Constraints: planes,
+		ViewMin:     make([]float64, n),
+		ViewMax:     make([]float64, n),
+	}
+
+	// La vista es una caja que contiene todos los vértices del modelo; sus caras solo
+	// recortan la región cuando no es acotada
+	size := viewSize(planes, n)
+	var view []models.RegionPlane
+	for j, sign := range p.signs {
+		if sign != models.SignNonPositive {
+			region.ViewMax[j] = size
+			view = append(view, models.RegionPlane{Coefficients: unitRow(n, j), Type: "le", RHS: size})
+		}
+		if sign != models.SignNonNegative {
+			region.ViewMin[j] = -size
+			view = append(view, models.RegionPlane{Coefficients: unitRow(n, j), Type: "ge", RHS: -size})
+		}
+	}
+
+	points := intersections(append(slices.Clone(planes), view...), n, func(x []float64) bool {
+		return satisfiesAll(planes, x) && satisfiesAll(view, x)
+	})
+	if n == 2 {
+		sortAround(points, nil)
+	}
+
+	region.Bounded = true
+	for _, x := range points {
+		vertex := models.RegionVertex{Objective: roundValue(dot(p.objective, x))}
+		for _, v := range x {
+			vertex.Point = append(vertex.Point, roundValue(v))
+		}
+		for _, plane := range planes {
+			if isActive(plane, x) {
+				vertex.Active = append(vertex.Active, plane.Name)
+			}
+		}
+		vertex.Clipped = slices.ContainsFunc(view, func(plane models.RegionPlane) bool { return isActive(plane, x) })
+		region.Bounded = region.Bounded && !vertex.Clipped
+		region.Vertices = append(region.Vertices, vertex)
+	}
+	if n == 3 {
+		region.Faces = regionFaces(points, planes, view)
+	}
+
+	state, z, _ := solveLP(p)
+	region.Status = state
+	if state == models.StatusOptimal {
+		optimal := roundValue(z)
+		region.Optimal = &optimal
+		for k, x := range points {
+			if !region.Vertices[k].Clipped && math.Abs(dot(p.objective, x)-z) <= tolerance(z) {
+				region.OptimalVertices = append(region.OptimalVertices, k)
+			}
+		}
+	}
+	if n == 2 {
+		region.IsoLines = isoLines(region.Vertices, p.maximize)
+	}
+
+	if result, err := SolveRequest(req); err == nil && len(result.TableauxHistory) > 0 {
+		region.Method = result.Method
+		region.Path = simplexPath(result.TableauxHistory, p.variables, points)
+	}
+	return region, nil
+}
+
+// regionPlanes son las filas del PL general más el signo de cada variable
+func regionPlanes(p linearProgram) []models.RegionPlane {
+	planes := make([]models.RegionPlane, 0, len(p.constraints)+len(p.signs))
+	for i, coefs := range p.constraints {
+		planes = append(planes, models.RegionPlane{Name: p.rows[i], Coefficients: coefs, Type: p.types[i], RHS: p.rhs[i]})
+	}
+	for j, sign := range p.signs {
+		switch sign {
+		case models.SignNonNegative:
+			planes = append(planes, models.RegionPlane{Name: p.variables[j] + " >= 0", Coefficients: unitRow(len(p.signs), j), Type: "ge"})
+		case models.SignNonPositive:
+			planes = append(planes, models.RegionPlane{Name: p.variables[j] + " <= 0", Coefficients: unitRow(len(p.signs), j), Type: "le"})
+		}
+	}
+	return planes
+}
+
+// viewSize elige el lado de la vista: un valor redondo holgadamente mayor que las
+// coordenadas de los vértices del modelo (o de todas las intersecciones si no hay)
+func viewSize(planes []models.RegionPlane, n int) float64 {
+	largest := func(points [][]float64) float64 {
+		m := 0.0
+		for _, x := range points {
+			for _, v := range x {
+				m = math.Max(m, math.Abs(v))
+			}
+		}
+		return m
+	}
+
+	scale := largest(intersections(planes, n, func(x []float64) bool { return satisfiesAll(planes, x) }))
+	if scale == 0 {
+		scale = largest(intersections(planes, n, func([]float64) bool { return true }))
+	}
+	if scale == 0 {
+		for _, plane := range planes {
+			scale = math.Max(scale, math.Abs(plane.RHS))
+		}
+	}
+	return niceCeil(1.5 * math.Max(scale, 1))
+}
+
+// niceCeil redondea hacia arriba a 1, 2, 2.5 o 5 por una potencia de 10
+func niceCeil(v float64) float64 {
+	power := math.Pow(10, math.Floor(math.Log10(v)))
+	for _, m := range []float64{1, 2, 2.5, 5} {
+		if m*power >= v {
+			return m * power
+		}
+	}
+	return 10 * power
+}
+
+// intersections interseca las restricciones de a n (como igualdades) y devuelve los
+// puntos sin repetir que cumplen keep
+func intersections(planes []models.RegionPlane, n int, keep func([]float64) bool) [][]float64 {
+	var points [][]float64
+	combinations(len(planes), n, func(rows []int) {
+		a := make([][]float64, n)
+		b := make([]float64, n)
+		for k, i := range rows {
+			a[k], b[k] = planes[i].Coefficients, planes[i].RHS
+		}
+		x, ok := solveSquare(a, b)
+		if !ok || !keep(x) {
+			return
+		}
+		if !slices.ContainsFunc(points, func(y []float64) bool { return samePoint(x, y) }) {
+			points = append(points, x)
+		}
+	})
+	return points
+}
+
+// combinations llama a fn con cada subconjunto de k índices de 0..n-1 (en orden)
+func combinations(n, k int, fn func([]int)) {
+	chosen := make([]int, 0, k)
+	var next func(start int)
+	next = func(start int) {
+		if len(chosen) == k {
+			fn(chosen)
+			return
+		}
+		for i := start; i <= n-(k-len(chosen)); i++ {
+			chosen = append(chosen, i)
+			next(i + 1)
+			chosen = chosen[:len(chosen)-1]
+		}
+	}
+	next(0)
+}
+
+// solveSquare resuelve a x = b por eliminación gaussiana con pivoteo parcial; ok es
+// false si el sistema es singular
+func solveSquare(a [][]float64, b []float64) ([]float64, bool) {
+	n := len(b)
+	m := make([][]float64, n)
+	for i := range a {
+		m[i] = append(slices.Clone(a[i]), b[i])
+	}
+	for col := 0; col < n; col++ {
+		best := col
+		for i := col + 1; i < n; i++ {
+			if math.Abs(m[i][col]) > math.Abs(m[best][col]) {
+				best = i
+			}
+		}
+		if math.Abs(m[best][col]) < 1e-10 {
+			return nil, false
+		}
+		m[col], m[best] = m[best], m[col]
+		for i := 0; i < n; i++ {
+			if i == col {
+				continue
+			}
+			factor := m[i][col] / m[col][col]
+			for j := col; j <= n; j++ {
+				m[i][j] -= factor * m[col][j]
+			}
+		}
+	}
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = m[i][n] / m[i][i]
+	}
+	return x, true
+}
+
+func samePoint(x, y []float64) bool {
+	for i := range x {
+		if math.Abs(x[i]-y[i]) > tolerance(x[i], y[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func isActive(plane models.RegionPlane, x []float64) bool {
+	lhs := dot(plane.Coefficients, x)
+	return math.Abs(lhs-plane.RHS) <= tolerance(lhs, plane.RHS)
+}
+
+func satisfiesAll(planes []models.RegionPlane, x []float64) bool {
+	for _, plane := range planes {
+		lhs := dot(plane.Coefficients, x)
+		tol := tolerance(lhs, plane.RHS)
+		switch {
+		case plane.Type == "le" && lhs > plane.RHS+tol,
+			plane.Type == "ge" && lhs < plane.RHS-tol,
+			plane.Type == "eq" && math.Abs(lhs-plane.RHS) > tol:
+			return false
+		}
+	}
+	return true
+}
+
+// sortAround ordena los puntos por ángulo alrededor de su centroide. En 3 variables
+// los puntos están en un plano de normal normal; en 2 variables normal es nil.
+func sortAround(points [][]float64, normal []float64) {
+	if len(points) < 3 {
+		return
+	}
+	center := make([]float64, len(points[0]))
+	for _, x := range points {
+		for i, v := range x {
+			center[i] += v / float64(len(points))
+		}
+	}
+
+	angle := func(x []float64) float64 { return math.Atan2(x[1]-center[1], x[0]-center[0]) }
+	if normal != nil {
+		// Ejes del plano: u hacia el punto más lejano del centroide y w = normal × u
+		u := slices.MaxFunc(points, func(x, y []float64) int {
+			return cmp.Compare(distance(x, center), distance(y, center))
+		})
+		u = subtract(u, center)
+		w := cross(normal, u)
+		angle = func(x []float64) float64 {
+			d := subtract(x, center)
+			return math.Atan2(dot(d, w), dot(d, u))
+		}
+	}
+	slices.SortStableFunc(points, func(x, y []float64) int { return cmp.Compare(angle(x), angle(y)) })
+}
+
+// regionFaces arma las caras del poliedro: los vértices activos en cada restricción
+// (o cara de la vista) que no están todos sobre una recta, en orden alrededor de la cara
+func regionFaces(points [][]float64, planes, view []models.RegionPlane) []models.RegionFace {
+	var faces []models.RegionFace
+	var seen [][]int
+	addFace := func(plane models.RegionPlane, clipped bool) {
+		var indices []int
+		var face [][]float64
+		for k, x := range points {
+			if isActive(plane, x) {
+				indices = append(indices, k)
+				face = append(face, x)
+			}
+		}
+		if len(face) < 3 || collinear(face) || slices.ContainsFunc(seen, func(s []int) bool { return slices.Equal(s, indices) }) {
+			return
+		}
+		seen = append(seen, indices)
+
+		sortAround(face, plane.Coefficients)
+		ordered := make([]int, len(face))
+		for i, x := range face {
+			ordered[i] = slices.IndexFunc(points, func(y []float64) bool { return samePoint(x, y) })
+		}
+		faces = append(faces, models.RegionFace{Constraint: plane.Name, Vertices: ordered, Clipped: clipped})
+	}
+	for _, plane := range planes {
+		addFace(plane, false)
+	}
+	for _, plane := range view {
+		addFace(plane, true)
+	}
+	return faces
+}
+
+func collinear(points [][]float64) bool {
+	for _, x := range points[2:] {
+		c := cross(subtract(points[1], points[0]), subtract(x, points[0]))
+		if math.Sqrt(dot(c, c)) > tolerance(distance(points[1], points[0])) {
+			return false
+		}
+	}
+	return true
+}
+
+func subtract(x, y []float64) []float64 {
+	d := make([]float64, len(x))
+	for i := range x {
+		d[i] = x[i] - y[i]
+	}
+	return d
+}
+
+func distance(x, y []float64) float64 {
+	d := subtract(x, y)
+	return math.Sqrt(dot(d, d))
+}
+
+func cross(a, b []float64) []float64 {
+	return []float64{a[1]*b[2] - a[2]*b[1], a[2]*b[0] - a[0]*b[2], a[0]*b[1] - a[1]*b[0]}
+}
+
+// isoLines elige 4 valores de Z repartidos entre el peor y el mejor vértice de la
+// vista, del peor al mejor (el último pasa por el óptimo si la región es acotada)
+func isoLines(vertices []models.RegionVertex, maximize bool) []float64 {
+	if len(vertices) == 0 {
+		return nil
+	}
+	worst, best := vertices[0].Objective, vertices[0].Objective
+	for _, v := range vertices {
+		worst, best = math.Min(worst, v.Objective), math.Max(best, v.Objective)
+	}
+	if !maximize {
+		worst, best = best, worst
+	}
+	if worst == best {
+		return []float64{best}
+	}
+	levels := make([]float64, 4)
+	for k := range levels {
+		levels[k] = roundValue(worst + (best-worst)*float64(k)/3)
+	}
+	return levels
+}
+
+// simplexPath ubica el punto de cada tabla del historial (las variables de decisión
+// no básicas valen 0) y lo asocia al vértice que visita. Los valores de las tablas
+// están truncados a 2 decimales, de ahí la tolerancia de 0.01.
+func simplexPath(history []models.TableauStep, variables []string, points [][]float64) []models.RegionStep {
+	path := make([]models.RegionStep, len(history))
+	for k, step := range history {
+		point := make([]float64, len(variables))
+		rhsCol := len(step.Headers) - 1
+		for i, name := range step.Basis {
+			if j := slices.Index(variables, name); j >= 0 && i > 0 {
+				point[j] = step.Matrix[i][rhsCol]
+			}
+		}
+		path[k] = models.RegionStep{Step: k, Point: point}
+		vertex := slices.IndexFunc(points, func(x []float64) bool {
+			for j := range x {
+				if math.Abs(x[j]-point[j]) > 0.01+tolerance(x[j]) {
+					return false
+				}
+			}
+			return true
+		})
+		if vertex >= 0 {
+			path[k].Vertex = &vertex
+		}
+	}
+	return path
+}
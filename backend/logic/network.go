@@ -0,0 +1,438 @@
+package logic
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"proyecto/simplex/i18n"
+	"proyecto/simplex/models"
+)
+
+// maxNetworkIterations limita los pivotes del simplex de redes
+const maxNetworkIterations = 1000
+
+// networkArc es un arco de la red resuelta con los nodos como índices (upper +Inf si
+// la capacidad es ilimitada)
+type networkArc struct {
+	from, to           int
+	cost, lower, upper float64
+	artificial         bool
+}
+
+// SolveNetwork resuelve el problema de flujo de costo mínimo con el simplex de redes:
+// en lugar de la tabla densa usa un árbol generador con una raíz artificial unida a
+// cada nodo por un arco de costo M (que absorbe la oferta que todavía no circula por
+// la red). En cada iteración calcula los potenciales sobre el árbol, hace entrar el
+// arco con el costo reducido más violado, mueve el flujo por el ciclo que forma con
+// el árbol y saca el último arco bloqueante desde el vértice común (árbol fuertemente
+// factible, evita ciclar con soluciones degeneradas). El flujo máximo se resuelve con
+// un arco de retorno de Sink a Source de costo -1 y el camino más corto enviando una
+// unidad de Source a Sink sin capacidades.
+func SolveNetwork(req models.NetworkRequest) (models.NetworkResponse, error) {
+	if err := validateNetwork(req); err != nil {
+		return models.NetworkResponse{}, err
+	}
+	lang := req.Language
+	response := models.NetworkResponse{Problem: req.Problem}
+	if response.Problem == "" {
+		response.Problem = models.NetworkMinCost
+	}
+
+	arcs, supply := networkModel(req, response.Problem)
+	n := len(req.Nodes)
+	root := n
+	for _, node := range req.Nodes {
+		response.Nodes = append(response.Nodes, node.Name)
+	}
+	response.Nodes = append(response.Nodes, i18n.Message(lang, i18n.NetworkRoot))
+
+	// Árbol inicial: los arcos pedidos quedan en su cota inferior y cada nodo manda a
+	// la raíz (o recibe de ella) lo que le falta para conservar el flujo
+	flow := make([]float64, len(arcs))
+	excess := slices.Clone(supply)
+	for k, arc := range arcs {
+		flow[k] = arc.lower
+		excess[arc.from] -= arc.lower
+		excess[arc.to] += arc.lower
+	}
+	bigM := 1.0
+	for _, arc := range arcs {
+		bigM += math.Abs(arc.cost)
+	}
+	var tree []int
+	for i, e := range excess[:root] {
+		tree = append(tree, len(arcs))
+		if e >= 0 {
+			arcs = append(arcs, networkArc{from: i, to: root, cost: bigM, upper: math.Inf(1), artificial: true})
+		} else {
+			arcs = append(arcs, networkArc{from: root, to: i, cost: bigM, upper: math.Inf(1), artificial: true})
+		}
+		flow = append(flow, math.Abs(e))
+	}
+	inTree := make([]bool, len(arcs))
+	for _, k := range tree {
+		inTree[k] = true
+	}
+	atUpper := make([]bool, len(arcs))
+
+	values := []float64{bigM}
+	for _, arc := range arcs {
+		values = append(values, arc.lower, arc.upper)
+	}
+	values = slices.DeleteFunc(values, func(v float64) bool { return math.IsInf(v, 1) })
+	tol := tolerance(values...)
+
+	response.Status = models.StatusIterationLimit
+	var reduced []float64
+	var potentials []float64
+	for iteration := 0; iteration <= maxNetworkIterations; iteration++ {
+		var parent, parentArc, depth []int
+		potentials, parent, parentArc, depth = networkTree(arcs, tree, root)
+		reduced = make([]float64, len(arcs))
+		entering, best := -1, tol
+		for k, arc := range arcs {
+			if inTree[k] {
+				continue
+			}
+			reduced[k] = arc.cost - potentials[arc.from] + potentials[arc.to]
+			// Los arcos artificiales que salen del árbol no vuelven a entrar
+			violation := -reduced[k]
+			if atUpper[k] {
+				violation = reduced[k]
+			}
+			if !arc.artificial && violation > best {
+				entering, best = k, violation
+			}
+		}
+		step := networkIteration(arcs, tree, flow, potentials, reduced, inTree)
+		if entering < 0 {
+			response.Status = models.StatusOptimal
+			response.Iterations = append(response.Iterations, step)
+			break
+		}
+		if iteration == maxNetworkIterations {
+			response.Iterations = append(response.Iterations, step)
+			break
+		}
+
+		cycle := networkCycle(arcs, entering, atUpper[entering], parent, parentArc, depth)
+		theta, leaving := math.Inf(1), -1
+		for c, ca := range cycle {
+			residual := flow[ca.Arc] - arcs[ca.Arc].lower
+			if ca.Forward {
+				residual = arcs[ca.Arc].upper - flow[ca.Arc]
+			}
+			if residual <= theta+tol {
+				if residual < theta-tol || leaving < 0 {
+					theta = residual
+				}
+				leaving = c
+			}
+		}
+		step.Entering, step.Cycle = &entering, cycle
+		if math.IsInf(theta, 1) {
+			response.Status = models.StatusUnbounded
+			response.Iterations = append(response.Iterations, step)
+			break
+		}
+		for _, ca := range cycle {
+			if ca.Forward {
+				flow[ca.Arc] += theta
+			} else {
+				flow[ca.Arc] -= theta
+			}
+		}
+
+		// El arco que sale queda en la cota que alcanzó; si es el mismo que entra solo
+		// cambia de cota y el árbol no cambia
+		out := cycle[leaving]
+		if out.Forward {
+			flow[out.Arc], atUpper[out.Arc] = arcs[out.Arc].upper, true
+		} else {
+			flow[out.Arc], atUpper[out.Arc] = arcs[out.Arc].lower, false
+		}
+		if out.Arc != entering {
+			inTree[out.Arc], inTree[entering] = false, true
+			tree[slices.Index(tree, out.Arc)] = entering
+		}
+		step.Theta, step.Leaving = truncate(theta), &out.Arc
+		response.Iterations = append(response.Iterations, step)
+	}
+
+	final := response.Iterations[len(response.Iterations)-1]
+	for k, arc := range arcs {
+		result := models.NetworkArcFlow{
+			NetworkArc:  models.NetworkArc{From: response.Nodes[arc.from], To: response.Nodes[arc.to], Cost: arc.cost, Lower: arc.lower},
+			Flow:        final.Flows[k],
+			ReducedCost: final.ReducedCosts[k],
+		}
+		if !math.IsInf(arc.upper, 1) {
+			upper := arc.upper
+			result.Upper = &upper
+		}
+		switch {
+		case arc.artificial:
+			result.Kind = models.ArcArtificial
+		case k >= len(req.Arcs):
+			result.Kind = models.ArcReturn
+		}
+		response.Arcs = append(response.Arcs, result)
+	}
+	for i := range n {
+		response.Potentials = append(response.Potentials, truncate(potentials[i]-potentials[0])+0)
+	}
+	response.TotalCost = final.Cost
+
+	// Si después de optimizar queda flujo en un arco artificial, la oferta no se
+	// puede enviar por la red
+	if response.Status == models.StatusOptimal {
+		for k, arc := range arcs {
+			if arc.artificial && flow[k] > tol {
+				response.Status = models.StatusInfeasible
+			}
+		}
+	}
+	response.Message = networkMessage(req, &response, flow, tol)
+	return response, nil
+}
+
+// validateNetwork verifica los nodos, los arcos y el tipo de problema, y devuelve
+// todos los problemas encontrados
+func validateNetwork(req models.NetworkRequest) error {
+	var errs ValidationErrors
+	if len(req.Nodes) == 0 {
+		errs = append(errs, newFieldError("nodes", i18n.NetworkEmptyNodes))
+	}
+	index := map[string]int{}
+	var balance float64
+	for i, node := range req.Nodes {
+		if _, repeated := index[node.Name]; node.Name == "" || repeated {
+			fe := newFieldError(fmt.Sprintf("nodes[%d].name", i), i18n.NetworkNodeName)
+			fe.Details = map[string]any{"value": node.Name}
+			errs = append(errs, fe)
+		}
+		index[node.Name] = i
+		if !isFinite(node.Supply) {
+			errs = append(errs, nonFinite(fmt.Sprintf("nodes[%d].supply", i), i18n.NetworkNonFinite, node.Supply))
+		}
+		balance += node.Supply
+	}
+
+	for k, arc := range req.Arcs {
+		field := fmt.Sprintf("arcs[%d]", k)
+		_, fromOK := index[arc.From]
+		_, toOK := index[arc.To]
+		switch {
+		case !fromOK:
+			errs = append(errs, newFieldError(field+".from", i18n.NetworkUnknownNode, arc.From))
+		case !toOK:
+			errs = append(errs, newFieldError(field+".to", i18n.NetworkUnknownNode, arc.To))
+		case arc.From == arc.To:
+			errs = append(errs, newFieldError(field, i18n.NetworkSelfLoop))
+		}
+		if !isFinite(arc.Cost) {
+			errs = append(errs, nonFinite(field+".cost", i18n.NetworkNonFinite, arc.Cost))
+		}
+		if !isFinite(arc.Lower) || arc.Lower < 0 || (arc.Upper != nil && (!isFinite(*arc.Upper) || *arc.Upper < arc.Lower)) {
+			fe := newFieldError(field, i18n.NetworkInvalidBounds)
+			fe.Details = map[string]any{"lower": fmt.Sprint(arc.Lower)}
+			if arc.Upper != nil {
+				fe.Details["upper"] = fmt.Sprint(*arc.Upper)
+			}
+			errs = append(errs, fe)
+		}
+	}
+
+	switch req.Problem {
+	case "", models.NetworkMinCost:
+		if math.Abs(balance) > tolerance(balance) {
+			errs = append(errs, newFieldError("nodes", i18n.NetworkUnbalanced, formatValue(balance)))
+		}
+	case models.NetworkMaxFlow, models.NetworkShortestPath:
+		_, sourceOK := index[req.Source]
+		_, sinkOK := index[req.Sink]
+		if !sourceOK || !sinkOK || req.Source == req.Sink {
+			fe := newFieldError("source", i18n.NetworkSourceSink)
+			fe.Details = map[string]any{"source": req.Source, "sink": req.Sink}
+			errs = append(errs, fe)
+		}
+	default:
+		errs = append(errs, newFieldError("problem", i18n.NetworkUnknownProblem, req.Problem))
+	}
+	return errs.orNil()
+}
+
+// networkModel arma los arcos y las ofertas que resuelve el solver según el tipo de
+// problema (los nodos ya fueron validados)
+func networkModel(req models.NetworkRequest, problem string) ([]networkArc, []float64) {
+	index := map[string]int{}
+	supply := make([]float64, len(req.Nodes)+1) // la raíz tiene oferta 0
+	for i, node := range req.Nodes {
+		index[node.Name] = i
+		if problem == models.NetworkMinCost {
+			supply[i] = node.Supply
+		}
+	}
+
+	var arcs []networkArc
+	for _, a := range req.Arcs {
+		arc := networkArc{from: index[a.From], to: index[a.To], cost: a.Cost, lower: a.Lower, upper: math.Inf(1)}
+		if a.Upper != nil {
+			arc.upper = *a.Upper
+		}
+		switch problem {
+		case models.NetworkMaxFlow:
+			arc.cost = 0
+		case models.NetworkShortestPath:
+			arc.lower, arc.upper = 0, math.Inf(1)
+		}
+		arcs = append(arcs, arc)
+	}
+
+	switch problem {
+	case models.NetworkMaxFlow:
+		arcs = append(arcs, networkArc{from: index[req.Sink], to: index[req.Source], cost: -1, upper: math.Inf(1)})
+	case models.NetworkShortestPath:
+		supply[index[req.Source]] = 1
+		supply[index[req.Sink]] = -1
+	}
+	return arcs, supply
+}
+
+// networkTree recorre el árbol desde la raíz y devuelve los potenciales (costo
+// reducido 0 en los arcos del árbol), el padre de cada nodo, el arco que los une y
+// la profundidad
+func networkTree(arcs []networkArc, tree []int, root int) (potentials []float64, parent, parentArc, depth []int) {
+	nodes := root + 1
+	adjacent := make([][]int, nodes)
+	for _, k := range tree {
+		adjacent[arcs[k].from] = append(adjacent[arcs[k].from], k)
+		adjacent[arcs[k].to] = append(adjacent[arcs[k].to], k)
+	}
+	potentials = make([]float64, nodes)
+	parent, parentArc, depth = make([]int, nodes), make([]int, nodes), make([]int, nodes)
+	visited := make([]bool, nodes)
+	visited[root], parent[root], parentArc[root] = true, -1, -1
+	queue := []int{root}
+	for len(queue) > 0 {
+		u := queue[0]
+		queue = queue[1:]
+		for _, k := range adjacent[u] {
+			arc := arcs[k]
+			v := arc.to
+			if v == u {
+				v = arc.from
+			}
+			if visited[v] {
+				continue
+			}
+			visited[v] = true
+			parent[v], parentArc[v], depth[v] = u, k, depth[u]+1
+			if arc.from == u {
+				potentials[v] = potentials[u] - arc.cost
+			} else {
+				potentials[v] = potentials[u] + arc.cost
+			}
+			queue = append(queue, v)
+		}
+	}
+	return potentials, parent, parentArc, depth
+}
+
+// networkCycle arma el ciclo del arco que entra con el árbol. Se orienta según el
+// arco que entra (de from a to si está en su cota inferior, al revés si está en la
+// superior) y se recorre desde el vértice común de sus extremos.
+func networkCycle(arcs []networkArc, entering int, atUpper bool, parent, parentArc, depth []int) []models.NetworkCycleArc {
+	p, q := arcs[entering].from, arcs[entering].to
+	if atUpper {
+		p, q = q, p
+	}
+
+	// Caminos de p y q hacia el vértice común, subiendo primero por el más profundo
+	var down, up []models.NetworkCycleArc
+	for p != q {
+		if depth[p] >= depth[q] {
+			down = append(down, models.NetworkCycleArc{Arc: parentArc[p], Forward: arcs[parentArc[p]].to == p})
+			p = parent[p]
+		} else {
+			up = append(up, models.NetworkCycleArc{Arc: parentArc[q], Forward: arcs[parentArc[q]].from == q})
+			q = parent[q]
+		}
+	}
+	slices.Reverse(down)
+	cycle := append(down, models.NetworkCycleArc{Arc: entering, Forward: !atUpper})
+	return append(cycle, up...)
+}
+
+// networkIteration arma el registro de una iteración con los valores truncados. El
+// costo solo suma los arcos que no son artificiales.
+func networkIteration(arcs []networkArc, tree []int, flow, potentials, reduced []float64, inTree []bool) models.NetworkIteration {
+	step := models.NetworkIteration{Tree: slices.Sorted(slices.Values(tree))}
+	for k, arc := range arcs {
+		step.Flows = append(step.Flows, truncate(flow[k])+0)
+		var rc *float64
+		if !inTree[k] {
+			v := truncate(reduced[k]) + 0
+			rc = &v
+		}
+		step.ReducedCosts = append(step.ReducedCosts, rc)
+		if !arc.artificial {
+			step.Cost += arc.cost * flow[k]
+		}
+	}
+	for _, p := range potentials {
+		step.Potentials = append(step.Potentials, truncate(p)+0)
+	}
+	step.Cost = truncate(step.Cost) + 0
+	return step
+}
+
+// networkMessage arma el mensaje final y completa el flujo máximo o el camino más
+// corto según el tipo de problema
+func networkMessage(req models.NetworkRequest, response *models.NetworkResponse, flow []float64, tol float64) string {
+	lang := req.Language
+	iterations := len(response.Iterations) - 1
+	switch {
+	case response.Status == models.StatusIterationLimit:
+		return i18n.Message(lang, i18n.StatusIterationLimit)
+	case response.Problem == models.NetworkMaxFlow && response.Status == models.StatusUnbounded:
+		return i18n.Message(lang, i18n.NetworkInfiniteFlow, req.Source, req.Sink)
+	case response.Problem == models.NetworkShortestPath && response.Status == models.StatusUnbounded:
+		return i18n.Message(lang, i18n.NetworkNegativeCycle)
+	case response.Problem == models.NetworkShortestPath && response.Status == models.StatusInfeasible:
+		return i18n.Message(lang, i18n.NetworkNoPath, req.Source, req.Sink)
+	case response.Status == models.StatusUnbounded:
+		return i18n.Message(lang, i18n.StatusUnbounded)
+	case response.Status == models.StatusInfeasible:
+		return i18n.Message(lang, i18n.StatusInfeasible)
+	}
+
+	switch response.Problem {
+	case models.NetworkMaxFlow:
+		response.FlowValue = response.Arcs[len(req.Arcs)].Flow
+		return i18n.Message(lang, i18n.NetworkMaxFlowOptimal, req.Source, req.Sink, formatValue(response.FlowValue), iterations)
+	case models.NetworkShortestPath:
+		// La unidad enviada recorre un único camino (la solución es básica)
+		node := req.Source
+		response.Path = []string{node}
+		for node != req.Sink && len(response.Path) <= len(req.Nodes) {
+			next := -1
+			for k, a := range req.Arcs {
+				if a.From == node && flow[k] > tol {
+					next = k
+					break
+				}
+			}
+			if next < 0 {
+				break
+			}
+			node = req.Arcs[next].To
+			response.Path = append(response.Path, node)
+		}
+		return i18n.Message(lang, i18n.NetworkShortestPathOptimal, req.Source, req.Sink, strings.Join(response.Path, " → "), formatValue(response.TotalCost), iterations)
+	default:
+		return i18n.Message(lang, i18n.NetworkMinCostOptimal, formatValue(response.TotalCost), iterations)
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"errors"
 	"fmt"
 	"math"
-	"strings"
 
 	"proyecto/simplex/models"
 )
@@ -66,36 +65,46 @@ func findPivotColumn(tableau models.SimplexTableau) (int, error) {
 	}
 
 	if pivotCol == -1 {
-		return -1, errors.New("solución óptima encontrada")
+		return -1, ErrOptimalReached
 	}
 	return pivotCol, nil
 }
 
-// findPivotRow (Primal) realiza la prueba del cociente mínimo para encontrar la fila pivote
-func findPivotRow(tableau models.SimplexTableau, pivotCol int) (int, error) {
-	numRows := len(tableau)
+// ratioTest (Primal) calcula el cociente RHS / elemento de la columna pivote para cada
+// fila de la tabla. Las filas con divisor no positivo (y la fila Z) valen +Inf.
+func ratioTest(tableau models.SimplexTableau, pivotCol int) []float64 {
 	rhsCol := len(tableau[0]) - 1
-
-	minRatio := math.Inf(1)
-	pivotRow := -1
+	ratios := make([]float64, len(tableau))
+	ratios[Z_ROW_INDEX] = math.Inf(1)
 
 	// Iterar sobre las filas de restricción (índice 1 en adelante)
-	for i := 1; i < numRows; i++ {
+	for i := 1; i < len(tableau); i++ {
 		pivotElement := tableau[i][pivotCol]
-		rhs := tableau[i][rhsCol]
 
 		// Los divisores deben ser positivos
 		if pivotElement > 1e-9 {
-			ratio := rhs / pivotElement
-			if ratio < minRatio {
-				minRatio = ratio
-				pivotRow = i
-			}
+			ratios[i] = tableau[i][rhsCol] / pivotElement
+		} else {
+			ratios[i] = math.Inf(1)
+		}
+	}
+	return ratios
+}
+
+// findPivotRow (Primal) realiza la prueba del cociente mínimo para encontrar la fila pivote
+func findPivotRow(tableau models.SimplexTableau, pivotCol int) (int, error) {
+	minRatio := math.Inf(1)
+	pivotRow := -1
+
+	for i, ratio := range ratioTest(tableau, pivotCol) {
+		if ratio < minRatio {
+			minRatio = ratio
+			pivotRow = i
 		}
 	}
 
 	if pivotRow == -1 {
-		return -1, errors.New("problema ilimitado (unbounded)")
+		return -1, ErrUnbounded
 	}
 
 	return pivotRow, nil
@@ -105,19 +114,19 @@ func findPivotRow(tableau models.SimplexTableau, pivotCol int) (int, error) {
 func SolvePrimalSimplexDetailed(objective []float64, constraints [][]float64, rhs []float64) models.SimplexResponse {
 	response := models.SimplexResponse{
 		Variables: make(map[string]float64),
-		Status:    "error: unknown",
+		Method:    "primal",
 	}
 
 	// 1. Validar entrada
 	if err := ValidarEntrada(objective, constraints, rhs); err != nil {
-		response.Status = "error: " + err.Error()
+		setStatus(&response, models.StatusError, err)
 		return response
 	}
 	// El Simplex Primal requiere que RHS >= 0 para comenzar.
 	for _, val := range rhs {
 		if val < -1e-9 {
 			// Si hay un RHS negativo, el problema es inviable para el Primal simple.
-			response.Status = "infeasible"
+			setStatus(&response, models.StatusInfeasible, nil)
 			return response
 		}
 	}
@@ -129,20 +138,10 @@ func SolvePrimalSimplexDetailed(objective []float64, constraints [][]float64, rh
 
 	// 2. Construir la tabla inicial
 	currentTableau := buildInitialTableau(objective, constraints, rhs)
+	basis := initialBasis(numVariables, numConstraints)
 
-	// --- Lógica de truncamiento en línea para la Tabla Inicial ---
-	// Guardar una copia truncada para la visualización (Tabla 0)
-	truncatedInitial := copyTableau(currentTableau)
-	for i, row := range truncatedInitial {
-		for j, val := range row {
-			truncatedInitial[i][j] = math.Trunc(val*100) / 100
-		}
-	}
-	// Guardar la tabla inicial ENCAPSULADA
-	response.TableauxHistory = append(response.TableauxHistory, models.TableauStep{
-		Headers: headers,
-		Matrix:  truncatedInitial,
-	})
+	// Guardar la tabla inicial (Tabla 0) truncada para la visualización
+	response.TableauxHistory = append(response.TableauxHistory, newTableauStep(headers, currentTableau, basis))
 
 	// Si la función objetivo es constante (todos los coeficientes 0),
 	// devolver la primera solución factible trivial.
@@ -158,7 +157,9 @@ func SolvePrimalSimplexDetailed(objective []float64, constraints [][]float64, rh
 			response.Variables[fmt.Sprintf("x%d", j)] = 0.0
 		}
 		response.Optimal = 0.0
-		response.Status = "optimal (degenerate: multiple solutions)"
+		response.ConstantObjective = true
+		setStatus(&response, models.StatusOptimal, nil)
+		markSolutionFlags(&response, headers, currentTableau, basis)
 		return response
 	}
 
@@ -169,42 +170,41 @@ func SolvePrimalSimplexDetailed(objective []float64, constraints [][]float64, rh
 		// 3. Encontrar columna pivote (Primal: más negativo en Z)
 		pivotCol, err := findPivotColumn(currentTableau)
 		if err != nil {
-			if strings.Contains(err.Error(), "óptima") {
-				response.Status = "optimal"
+			if errors.Is(err, ErrOptimalReached) {
+				setStatus(&response, models.StatusOptimal, nil)
 				break
 			}
-			response.Status = "error: " + err.Error()
+			setStatus(&response, models.StatusError, err)
 			return response
 		}
 
 		// 4. Encontrar fila pivote (Primal: Cociente Mínimo)
+		lastStep := &response.TableauxHistory[len(response.TableauxHistory)-1]
+		ratios := ratioTest(currentTableau, pivotCol)
+		lastStep.Ratios = truncatedRatios(ratios)
 		pivotRow, err := findPivotRow(currentTableau, pivotCol)
 		if err != nil {
-			response.Status = "unbounded"
+			lastStep.Entering = headers[pivotCol]
+			lastStep.PivotCol = pivotCol
+			setStatus(&response, models.StatusUnbounded, nil)
 			return response
 		}
+		recordPivot(lastStep, currentTableau, basis, pivotRow, pivotCol)
+		markDegeneratePivot(&response, lastStep, ratios[pivotRow])
 
 		// 5. Pivoteo
 		currentTableau = pivot(currentTableau, pivotRow, pivotCol)
+		basis[pivotRow-1] = pivotCol
 
-		// --- Lógica de truncamiento en línea para las Tablas Intermedias ---
-		// Guardar una copia truncada para la visualización
-		truncatedStep := copyTableau(currentTableau)
-		for i, row := range truncatedStep {
-			for j, val := range row {
-				truncatedStep[i][j] = math.Trunc(val*100) / 100
-			}
-		}
-		// Guardar el resultado del pivoteo ENCAPSULADO
-		response.TableauxHistory = append(response.TableauxHistory, models.TableauStep{
-			Headers: headers,
-			Matrix:  truncatedStep,
-		})
+		// Guardar el resultado del pivoteo truncado para la visualización
+		response.TableauxHistory = append(response.TableauxHistory, newTableauStep(headers, currentTableau, basis))
 	}
 
-	if response.Status != "optimal" {
+	if response.State != models.StatusOptimal {
+		setStatus(&response, models.StatusIterationLimit, nil)
 		return response
 	}
+	markSolutionFlags(&response, headers, currentTableau, basis)
 
 	// 6. Extracción de resultados
 	response.Optimal = currentTableau[Z_ROW_INDEX][rhsCol]
@@ -0,0 +1,310 @@
+package logic
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+
+	"proyecto/simplex/models"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize/convex/lp"
+)
+
+// linearProgram es un PL en forma general: filas le/ge/eq y un signo por variable.
+// Es la forma que se dualiza y que resuelve el solver de referencia (admite
+// igualdades y variables libres, a diferencia del tableau).
+type linearProgram struct {
+	maximize    bool
+	objective   []float64
+	constraints [][]float64
+	rhs         []float64
+	types       []string
+	signs       []models.VariableSign
+	variables   []string // nombre de cada variable
+	rows        []string // nombre de cada restricción
+}
+
+// generalForm convierte el modelo en un PL general. Las cotas fijan el signo de la
+// variable (libre o no positiva) y las cotas finitas distintas de 0 se agregan como
+// filas (lb_x >= l, ub_x <= u).
+func generalForm(req models.SimplexRequest) linearProgram {
+	p := linearProgram{
+		maximize:    req.Type == "max",
+		objective:   req.Objective,
+		constraints: append([][]float64{}, req.Constraints...),
+		rhs:         append([]float64{}, req.RHS...),
+		types:       append([]string{}, req.ConstraintTypes...),
+	}
+	for j := range req.Objective {
+		p.variables = append(p.variables, variableName(req.VariableNames, j))
+	}
+	for i := range req.Constraints {
+		name := fmt.Sprintf("c%d", i+1)
+		if i < len(req.ConstraintNames) && req.ConstraintNames[i] != "" {
+			name = req.ConstraintNames[i]
+		}
+		p.rows = append(p.rows, name)
+	}
+
+	addRow := func(j int, rhs float64, kind, name string) {
+		p.constraints = append(p.constraints, unitRow(len(req.Objective), j))
+		p.rhs = append(p.rhs, rhs)
+		p.types = append(p.types, kind)
+		p.rows = append(p.rows, name)
+	}
+	for j := range req.Objective {
+		sign := models.SignNonNegative
+		if j < len(req.Bounds) {
+			b := req.Bounds[j]
+			switch {
+			case b.Free && b.Upper != nil && *b.Upper == 0:
+				sign = models.SignNonPositive
+			case b.Free:
+				sign = models.SignFree
+			case b.Lower != nil && *b.Lower < 0:
+				sign = models.SignFree
+				addRow(j, *b.Lower, "ge", "lb_"+p.variables[j])
+			case b.Lower != nil && *b.Lower > 0:
+				addRow(j, *b.Lower, "ge", "lb_"+p.variables[j])
+			}
+			if b.Upper != nil && sign != models.SignNonPositive {
+				addRow(j, *b.Upper, "le", "ub_"+p.variables[j])
+			}
+		}
+		p.signs = append(p.signs, sign)
+	}
+	return p
+}
+
+// request convierte el PL general en un modelo de la API; los signos de las
+// variables van como cotas
+func (p linearProgram) request() models.SimplexRequest {
+	req := models.SimplexRequest{
+		Objective:       p.objective,
+		Constraints:     p.constraints,
+		RHS:             p.rhs,
+		Type:            "min",
+		ConstraintTypes: p.types,
+		VariableNames:   p.variables,
+		ConstraintNames: p.rows,
+	}
+	if p.maximize {
+		req.Type = "max"
+	}
+
+	signed := false
+	bounds := make([]models.Bound, len(p.signs))
+	for j, s := range p.signs {
+		switch s {
+		case models.SignNonPositive:
+			zero := 0.0
+			bounds[j] = models.Bound{Free: true, Upper: &zero}
+			signed = true
+		case models.SignFree:
+			bounds[j] = models.Bound{Free: true}
+			signed = true
+		}
+	}
+	if signed {
+		req.Bounds = bounds
+	}
+	return req
+}
+
+// stdColumn es una columna de la forma estándar: la variable original que
+// representa (-1 para las holguras) y el factor con que entra (x = xp - xn, x = -x')
+type stdColumn struct {
+	variable int
+	factor   float64
+}
+
+// solveLP resuelve el PL general con lp.Simplex de gonum, llevándolo a forma estándar
+// (min c·x, A x = b, x >= 0). Devuelve el estado, el valor óptimo y los valores de
+// las variables sin truncar.
+func solveLP(p linearProgram) (models.Status, float64, []float64) {
+	var columns []stdColumn
+	for j, s := range p.signs {
+		switch s {
+		case models.SignNonNegative:
+			columns = append(columns, stdColumn{j, 1})
+		case models.SignNonPositive:
+			columns = append(columns, stdColumn{j, -1})
+		case models.SignFree:
+			columns = append(columns, stdColumn{j, 1}, stdColumn{j, -1})
+		}
+	}
+	numColumns := len(columns)
+	for _, t := range p.types {
+		if t != "eq" {
+			numColumns++
+		}
+	}
+
+	// Filas de la forma estándar (con b >= 0); las filas nulas se descartan
+	var rows [][]float64
+	var b []float64
+	slack := len(columns)
+	for i, coefs := range p.constraints {
+		row := make([]float64, numColumns)
+		for k, col := range columns {
+			row[k] = coefs[col.variable] * col.factor
+		}
+		switch p.types[i] {
+		case "le":
+			row[slack] = 1
+			slack++
+		case "ge":
+			row[slack] = -1
+			slack++
+		}
+		rhs := p.rhs[i]
+		if rhs < 0 {
+			for k := range row {
+				row[k] = -row[k]
+			}
+			rhs = -rhs
+		}
+		if isZeroVector(row) {
+			if rhs != 0 {
+				return models.StatusInfeasible, 0, nil
+			}
+			continue
+		}
+		rows = append(rows, row)
+		b = append(b, rhs)
+	}
+
+	// lp.Simplex necesita A de rango completo por filas: las igualdades redundantes se
+	// descartan y las contradictorias hacen infactible al problema
+	rows, b, consistent := independentRows(rows, b)
+	if !consistent {
+		return models.StatusInfeasible, 0, nil
+	}
+
+	// Columnas nulas: no afectan la factibilidad, pero si mejoran el objetivo el
+	// problema es ilimitado
+	var kept []int
+	unbounded := false
+	cost := func(k int) float64 {
+		if k >= len(columns) {
+			return 0
+		}
+		c := p.objective[columns[k].variable] * columns[k].factor
+		if p.maximize {
+			c = -c
+		}
+		return c
+	}
+	for k := 0; k < numColumns; k++ {
+		zero := true
+		for _, row := range rows {
+			if row[k] != 0 {
+				zero = false
+				break
+			}
+		}
+		if !zero {
+			kept = append(kept, k)
+		} else if cost(k) < 0 {
+			unbounded = true
+		}
+	}
+
+	values := make([]float64, len(p.objective))
+	if len(rows) > 0 {
+		data := make([]float64, 0, len(rows)*len(kept))
+		for _, row := range rows {
+			for _, k := range kept {
+				data = append(data, row[k])
+			}
+		}
+		c := make([]float64, len(kept))
+		for i, k := range kept {
+			c[i] = cost(k)
+		}
+
+		_, x, err := lp.Simplex(c, mat.NewDense(len(rows), len(kept), data), b, 1e-10, nil)
+		switch {
+		case errors.Is(err, lp.ErrInfeasible):
+			return models.StatusInfeasible, 0, nil
+		case errors.Is(err, lp.ErrUnbounded):
+			return models.StatusUnbounded, 0, nil
+		case err != nil:
+			return models.StatusError, 0, nil
+		}
+		for i, k := range kept {
+			if k < len(columns) {
+				values[columns[k].variable] += x[i] * columns[k].factor
+			}
+		}
+	}
+	if unbounded {
+		return models.StatusUnbounded, 0, nil
+	}
+	return models.StatusOptimal, dot(p.objective, values), values
+}
+
+// independentRows devuelve las filas de [A | b] linealmente independientes, en el
+// orden original. Cada fila se reduce con las ya elegidas: si su parte de A se anula
+// es combinación de ellas y se descarta, salvo que su lado derecho no se anule, en
+// cuyo caso el sistema es incompatible y devuelve false.
+func independentRows(rows [][]float64, b []float64) ([][]float64, []float64, bool) {
+	var keptRows, reduced [][]float64
+	var keptB []float64
+	var pivots []int
+	for i, row := range rows {
+		r := append(slices.Clone(row), b[i])
+		tol := tolerance(r...)
+		for k, pivot := range pivots {
+			if f := r[pivot] / reduced[k][pivot]; f != 0 {
+				for j := range r {
+					r[j] -= f * reduced[k][j]
+				}
+			}
+		}
+		pivot := -1
+		for j := range row {
+			if math.Abs(r[j]) > tol && (pivot < 0 || math.Abs(r[j]) > math.Abs(r[pivot])) {
+				pivot = j
+			}
+		}
+		if pivot < 0 {
+			if math.Abs(r[len(row)]) > tol {
+				return nil, nil, false
+			}
+			continue
+		}
+		reduced, pivots = append(reduced, r), append(pivots, pivot)
+		keptRows, keptB = append(keptRows, row), append(keptB, b[i])
+	}
+	return keptRows, keptB, true
+}
+
+func isZeroVector(v []float64) bool {
+	for _, x := range v {
+		if x != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func dot(a, b []float64) float64 {
+	sum := 0.0
+	for i := range a {
+		sum += a[i] * b[i]
+	}
+	return sum
+}
+
+// tolerance es la tolerancia de las comparaciones numéricas, relativa a la escala
+// de los valores comparados
+func tolerance(values ...float64) float64 {
+	scale := 1.0
+	for _, v := range values {
+		scale = math.Max(scale, math.Abs(v))
+	}
+	return 1e-6 * scale
+}
@@ -0,0 +1,320 @@
+package logic
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"proyecto/simplex/i18n"
+	"proyecto/simplex/models"
+)
+
+// SolveRequest resuelve un modelo completo recibido por la API: valida el tipo,
+// convierte las cotas de las variables en restricciones y despacha al solver
+// de MAX o MIN. Las declaraciones de variables enteras se informan pero se
+// resuelve la relajación lineal. Con Explain se agrega a cada tabla la explicación
+// de la decisión de pivoteo y con Alternatives se enumeran las soluciones óptimas
+// alternativas. Los mensajes se generan en el idioma de req.Language. En los
+// modelos de MAX las cotas inferiores positivas se resuelven con el cambio de
+// variable x = x' + l (ver shiftLowerBounds): las tablas del historial están en x'
+// y la solución, el valor óptimo y la sensibilidad se informan en x.
+func SolveRequest(req models.SimplexRequest) (models.SimplexResponse, error) {
+	if err := ValidateRequest(req); err != nil {
+		return models.SimplexResponse{}, err
+	}
+
+	shifted, lower := shiftLowerBounds(req)
+	constraints, rhs, types, err := ApplyBounds(shifted)
+	if err != nil {
+		return models.SimplexResponse{}, err
+	}
+
+	var result models.SimplexResponse
+	if req.Type == "min" {
+		result = SolveSimplexMinWithTypes(req.Objective, constraints, rhs, types)
+	} else {
+		result = SolveSimplexMaxWithTypes(req.Objective, constraints, rhs, types)
+	}
+
+	result.Sensitivity = sensitivity(shifted, result, unshiftRHS(constraints, rhs, lower), types, lower)
+	restoreLowerBounds(&result, req, lower)
+	renameVariables(&result, req.VariableNames)
+	result.Message = statusMessage(result, req.Language)
+	if req.Explain {
+		explainSteps(&result, req.Language)
+	}
+	if req.Alternatives {
+		addAlternatives(&result, req, lower)
+	}
+	return result, nil
+}
+
+// statusMessage describe el estado de la respuesta en el idioma pedido
+func statusMessage(result models.SimplexResponse, lang string) string {
+	if result.Err != nil {
+		return i18n.Localize(result.Err, lang)
+	}
+	switch result.State {
+	case models.StatusOptimal:
+		if result.ConstantObjective {
+			return i18n.Message(lang, i18n.StatusMultiple)
+		}
+		if result.Degenerate {
+			return i18n.Message(lang, i18n.StatusOptimal) + ". " + i18n.Message(lang, i18n.StatusDegenerate)
+		}
+		return i18n.Message(lang, i18n.StatusOptimal)
+	case models.StatusUnbounded:
+		return i18n.Message(lang, i18n.StatusUnbounded)
+	case models.StatusInfeasible:
+		return i18n.Message(lang, i18n.StatusInfeasible)
+	}
+	return i18n.Message(lang, i18n.StatusIterationLimit)
+}
+
+// ValidateRequest valida el modelo completo antes de resolverlo: tipo, dimensiones,
+// valores, tipos de restricción y cotas. Devuelve ValidationErrors con todos los
+// problemas encontrados.
+func ValidateRequest(req models.SimplexRequest) error {
+	return validateModel(req, false).orNil()
+}
+
+// validateModel valida el modelo. Con general se aceptan restricciones de igualdad,
+// variables libres y cotas inferiores negativas (modelos que no se resuelven con el
+// tableau pero sí se pueden dualizar o verificar).
+func validateModel(req models.SimplexRequest, general bool) ValidationErrors {
+	var errs ValidationErrors
+
+	if req.Type != "max" && req.Type != "min" {
+		errs = append(errs, &FieldError{Field: "type", Err: ErrInvalidType, Details: map[string]any{"value": req.Type}})
+	}
+
+	var inputErrs ValidationErrors
+	if errors.As(ValidarEntrada(req.Objective, req.Constraints, req.RHS), &inputErrs) {
+		errs = append(errs, inputErrs...)
+	}
+
+	if len(req.ConstraintTypes) != len(req.Constraints) {
+		fe := newFieldError("constraint_types", i18n.ConstraintSizes)
+		fe.Details = map[string]any{"expected": len(req.Constraints), "got": len(req.ConstraintTypes)}
+		errs = append(errs, fe)
+	}
+	for i, t := range req.ConstraintTypes {
+		field := fmt.Sprintf("constraint_types[%d]", i)
+		switch {
+		case t == "le" || t == "ge" || (t == "eq" && general):
+		case t == "eq":
+			errs = append(errs, newUnsupportedError(field, ErrEqualityUnsupported))
+		default:
+			errs = append(errs, newFieldError(field, i18n.UnknownConstraint, t))
+		}
+	}
+
+	errs = append(errs, boundErrors(req, general)...)
+	return errs
+}
+
+// boundErrors valida las cotas de las variables: una por variable y, salvo en
+// modelos generales, sin variables libres ni cotas inferiores negativas (el solver
+// asume x >= 0)
+func boundErrors(req models.SimplexRequest, general bool) ValidationErrors {
+	if len(req.Bounds) == 0 {
+		return nil
+	}
+	if len(req.Bounds) != len(req.Objective) {
+		fe := newFieldError("bounds", i18n.BoundsLength)
+		fe.Details = map[string]any{"expected": len(req.Objective), "got": len(req.Bounds)}
+		return ValidationErrors{fe}
+	}
+	if general {
+		return nil
+	}
+
+	var errs ValidationErrors
+	for j, b := range req.Bounds {
+		if b.Free || (b.Lower != nil && *b.Lower < 0) {
+			errs = append(errs, newUnsupportedError(fmt.Sprintf("bounds[%d]", j), i18n.New(i18n.NegativeLowerBound, variableName(req.VariableNames, j))))
+		}
+	}
+	return errs
+}
+
+// ApplyBounds devuelve las restricciones del modelo con las cotas de las variables
+// agregadas como filas adicionales (x_j >= l y x_j <= u).
+// El solver asume x >= 0, por lo que no se admiten variables libres ni cotas inferiores negativas.
+func ApplyBounds(req models.SimplexRequest) ([][]float64, []float64, []string, error) {
+	constraints := req.Constraints
+	rhs := req.RHS
+	types := req.ConstraintTypes
+
+	if len(req.Bounds) == 0 {
+		return constraints, rhs, types, nil
+	}
+	if errs := boundErrors(req, false); len(errs) > 0 {
+		return nil, nil, nil, errs
+	}
+
+	constraints = append([][]float64{}, constraints...)
+	rhs = append([]float64{}, rhs...)
+	types = append([]string{}, types...)
+
+	for j, b := range req.Bounds {
+		if b.Lower != nil && *b.Lower > 0 {
+			constraints = append(constraints, unitRow(len(req.Objective), j))
+			rhs = append(rhs, *b.Lower)
+			types = append(types, "ge")
+		}
+		if b.Upper != nil {
+			constraints = append(constraints, unitRow(len(req.Objective), j))
+			rhs = append(rhs, *b.Upper)
+			types = append(types, "le")
+		}
+	}
+
+	return constraints, rhs, types, nil
+}
+
+// shiftLowerBounds sustituye x_j = x'_j + l_j en las variables con cota inferior
+// positiva de un modelo de MAX: la cota como fila >= deja un lado derecho negativo
+// al estandarizar, que el simplex primal informa como infactible. El modelo que
+// devuelve tiene los lados derechos corridos en A·l, las cotas superiores en u - l
+// y x' >= 0; lower guarda l_j por variable (nil si no se corrió nada). En MIN las
+// cotas quedan como filas >= que resuelve el simplex dual.
+func shiftLowerBounds(req models.SimplexRequest) (models.SimplexRequest, []float64) {
+	if req.Type != "max" || !slices.ContainsFunc(req.Bounds, func(b models.Bound) bool { return b.Lower != nil && *b.Lower > 0 }) {
+		return req, nil
+	}
+
+	lower := make([]float64, len(req.Objective))
+	req.Bounds = slices.Clone(req.Bounds)
+	for j, b := range req.Bounds {
+		if b.Lower == nil || *b.Lower <= 0 {
+			continue
+		}
+		lower[j] = *b.Lower
+		req.Bounds[j].Lower = nil
+		if b.Upper != nil {
+			upper := *b.Upper - lower[j]
+			req.Bounds[j].Upper = &upper
+		}
+	}
+	rhs := make([]float64, len(req.RHS))
+	for i, row := range req.Constraints {
+		rhs[i] = req.RHS[i] - dot(row, lower)
+	}
+	req.RHS = rhs
+	return req, lower
+}
+
+// unshiftRHS devuelve los lados derechos de las filas del modelo corrido por
+// shiftLowerBounds expresados en x (b + A·l)
+func unshiftRHS(constraints [][]float64, rhs []float64, lower []float64) []float64 {
+	if lower == nil {
+		return rhs
+	}
+	out := make([]float64, len(rhs))
+	for i, row := range constraints {
+		out[i] = rhs[i] + dot(row, lower)
+	}
+	return out
+}
+
+// restoreLowerBounds deshace el cambio de variable de shiftLowerBounds en la
+// solución óptima: suma l_j a cada variable y c·l al valor óptimo
+func restoreLowerBounds(result *models.SimplexResponse, req models.SimplexRequest, lower []float64) {
+	if lower == nil || result.State != models.StatusOptimal || result.FinalTableau == nil {
+		return
+	}
+	for j, l := range lower {
+		key := fmt.Sprintf("x%d", j+1)
+		result.Variables[key] = roundValue(result.Variables[key] + l)
+	}
+	exact := result.FinalTableau[Z_ROW_INDEX][len(result.FinalTableau[0])-1]
+	result.Optimal = roundValue(exact + dot(req.Objective, lower))
+}
+
+// unitRow devuelve una fila de ceros con un 1 en la columna j.
+func unitRow(numVariables, j int) []float64 {
+	row := make([]float64, numVariables)
+	row[j] = 1
+	return row
+}
+
+// variableName devuelve el nombre de la variable j (x1, x2... si no hay nombres).
+func variableName(names []string, j int) string {
+	if j < len(names) && names[j] != "" {
+		return names[j]
+	}
+	return fmt.Sprintf("x%d", j+1)
+}
+
+// constraintRowNames nombra las filas en el orden de ApplyBounds: las restricciones
+// (c1, c2... o constraint_names) y después las cotas (lb_x, ub_x)
+func constraintRowNames(req models.SimplexRequest) []string {
+	var rows []string
+	for i := range req.Constraints {
+		name := fmt.Sprintf("c%d", i+1)
+		if i < len(req.ConstraintNames) && req.ConstraintNames[i] != "" {
+			name = req.ConstraintNames[i]
+		}
+		rows = append(rows, name)
+	}
+	for j, b := range req.Bounds {
+		if b.Lower != nil && *b.Lower > 0 {
+			rows = append(rows, "lb_"+variableName(req.VariableNames, j))
+		}
+		if b.Upper != nil {
+			rows = append(rows, "ub_"+variableName(req.VariableNames, j))
+		}
+	}
+	return rows
+}
+
+// renameVariables reemplaza x1...xn por los nombres del modelo en la respuesta
+// y en los encabezados de cada tabla.
+func renameVariables(result *models.SimplexResponse, names []string) {
+	if len(names) == 0 {
+		return
+	}
+
+	renamed := make(map[string]string, len(names))
+	for j := range names {
+		renamed[fmt.Sprintf("x%d", j+1)] = variableName(names, j)
+	}
+
+	if result.Variables != nil {
+		variables := make(map[string]float64, len(result.Variables))
+		for key, val := range result.Variables {
+			if name, ok := renamed[key]; ok {
+				key = name
+			}
+			variables[key] = val
+		}
+		result.Variables = variables
+	}
+
+	rename := func(name string) string {
+		if renamedName, ok := renamed[name]; ok {
+			return renamedName
+		}
+		return name
+	}
+	for i, name := range result.ZeroReducedCost {
+		result.ZeroReducedCost[i] = rename(name)
+	}
+	for s := range result.TableauxHistory {
+		step := &result.TableauxHistory[s]
+		headers := make([]string, len(step.Headers))
+		for i, h := range step.Headers {
+			headers[i] = rename(h)
+		}
+		step.Headers = headers
+
+		basis := make([]string, len(step.Basis))
+		for i, b := range step.Basis {
+			basis[i] = rename(b)
+		}
+		step.Basis = basis
+		step.Entering = rename(step.Entering)
+		step.Leaving = rename(step.Leaving)
+	}
+}
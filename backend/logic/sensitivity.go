@@ -0,0 +1,119 @@
+package logic
+
+import (
+	"math"
+
+	"proyecto/simplex/models"
+)
+
+// sensitivity calcula el análisis de sensibilidad a partir de la tabla óptima sin
+// truncar. rhs y types son los de las filas que recibió el solver (restricciones y
+// cotas, antes de estandarizar) y lower las cotas inferiores corridas por
+// shiftLowerBounds, que se suman a los valores (nil si no hay). La fila Z guarda z_j - c_j, que en el óptimo es
+// >= 0 al maximizar y <= 0 al minimizar; si la tabla final no cumple esa condición
+// para el sentido pedido no hay base óptima que analizar y devuelve nil.
+func sensitivity(req models.SimplexRequest, result models.SimplexResponse, rhs []float64, types []string, lower []float64) *models.Sensitivity {
+	tableau, basis := result.FinalTableau, result.FinalBasis
+	if result.State != models.StatusOptimal || tableau == nil {
+		return nil
+	}
+	sense := 1.0
+	if req.Type == "min" {
+		sense = -1
+	}
+
+	n := len(req.Objective)
+	rhsCol := len(tableau[0]) - 1
+	zRow := tableau[Z_ROW_INDEX]
+	basicRow := make(map[int]int, len(basis))
+	for i, col := range basis {
+		basicRow[col] = i + 1
+	}
+	for j := 1; j < rhsCol; j++ {
+		if _, basic := basicRow[j]; !basic && sense*zRow[j] < -1e-9 {
+			return nil
+		}
+	}
+
+	value := func(col int) float64 {
+		if r, ok := basicRow[col]; ok {
+			return tableau[r][rhsCol]
+		}
+		return 0
+	}
+	report := &models.Sensitivity{}
+
+	// Coeficientes del objetivo: si c_j cambia en delta, la fila Z de las columnas no
+	// básicas cambia en delta veces la fila de x_j (o solo la de x_j si no es básica)
+	for j := range n {
+		col := j + 1
+		c := req.Objective[j]
+		shift := 0.0
+		if lower != nil {
+			shift = lower[j]
+		}
+		lower, upper := math.Inf(-1), math.Inf(1)
+		if r, ok := basicRow[col]; ok {
+			for k := 1; k < rhsCol; k++ {
+				if _, basic := basicRow[k]; basic {
+					continue
+				}
+				lower, upper = narrowRange(lower, upper, sense*zRow[k], sense*tableau[r][k])
+			}
+		} else {
+			lower, upper = narrowRange(lower, upper, sense*zRow[col], -sense)
+		}
+		report.Variables = append(report.Variables, models.VariableSensitivity{
+			Name:        variableName(req.VariableNames, j),
+			Value:       roundValue(value(col) + shift),
+			ReducedCost: roundValue(-zRow[col]),
+			Coefficient: c,
+			Lower:       rangeBound(c + lower),
+			Upper:       rangeBound(c + upper),
+		})
+	}
+
+	// Lados derechos: las filas >= se estandarizaron multiplicando por -1, así que su
+	// precio sombra y su rango cambian de signo respecto de la holgura estandarizada
+	for i, name := range constraintRowNames(req) {
+		col := n + 1 + i
+		sign := 1.0
+		if types[i] == "ge" {
+			sign = -1
+		}
+		lower, upper := math.Inf(-1), math.Inf(1)
+		for r := 1; r < len(tableau); r++ {
+			lower, upper = narrowRange(lower, upper, tableau[r][rhsCol], sign*tableau[r][col])
+		}
+		report.Constraints = append(report.Constraints, models.ConstraintSensitivity{
+			Name:        name,
+			Type:        types[i],
+			Slack:       roundValue(value(col)),
+			ShadowPrice: roundValue(sign * zRow[col]),
+			RHS:         rhs[i],
+			Lower:       rangeBound(rhs[i] + lower),
+			Upper:       rangeBound(rhs[i] + upper),
+		})
+	}
+	return report
+}
+
+// narrowRange acota [lower, upper] con la condición a + delta*b >= 0
+func narrowRange(lower, upper, a, b float64) (float64, float64) {
+	switch {
+	case b > 1e-9:
+		lower = math.Max(lower, -a/b)
+	case b < -1e-9:
+		upper = math.Min(upper, -a/b)
+	}
+	return lower, upper
+}
+
+// rangeBound devuelve el extremo de un rango para la respuesta (nil si es infinito)
+func rangeBound(v float64) *float64 {
+	if math.IsInf(v, 0) {
+		return nil
+	}
+	v = roundValue(v)
+	return &v
+}
@@ -0,0 +1,264 @@
+package logic
+
+import (
+	"math"
+	"slices"
+
+	"proyecto/simplex/i18n"
+	"proyecto/simplex/models"
+)
+
+// PivotSession es una sesión del modo interactivo: parte de la misma tabla inicial
+// que usaría el solver y el usuario elige cada pivote. Guarda todas las tablas para
+// poder deshacer.
+type PivotSession struct {
+	method       string // "primal" o "dual", igual que SolveRequest
+	headers      []string
+	numVariables int
+	lang         string
+	history      []basicTableau
+}
+
+// basicTableau es una tabla con la columna de la variable básica de cada fila
+type basicTableau struct {
+	tableau models.SimplexTableau
+	basis   []int
+}
+
+// NewPivotSession valida el modelo y arma la tabla inicial con el mismo método que
+// usaría el solver (ver initialTableau)
+func NewPivotSession(req models.SimplexRequest) (*PivotSession, error) {
+	method, headers, initial, err := initialTableau(req)
+	if err != nil {
+		return nil, err
+	}
+	return &PivotSession{
+		method:       method,
+		headers:      headers,
+		numVariables: len(req.Objective),
+		lang:         req.Language,
+		history:      []basicTableau{initial},
+	}, nil
+}
+
+// initialTableau arma la tabla inicial del modelo y elige el método como SolveRequest:
+// simplex dual para MIN con algún RHS negativo (después de pasar las >= a <=) y
+// primal en otro caso. Los encabezados usan los nombres de las variables.
+func initialTableau(req models.SimplexRequest) (string, []string, basicTableau, error) {
+	if err := ValidateRequest(req); err != nil {
+		return "", nil, basicTableau{}, err
+	}
+	constraints, rhs, types, err := ApplyBounds(req)
+	if err != nil {
+		return "", nil, basicTableau{}, err
+	}
+	stdConstraints, stdRHS, err := StandardizeConstraints(constraints, rhs, types)
+	if err != nil {
+		return "", nil, basicTableau{}, err
+	}
+
+	method := "primal"
+	if req.Type == "min" && slices.ContainsFunc(stdRHS, func(v float64) bool { return v < -1e-9 }) {
+		method = "dual"
+	}
+
+	numVariables := len(req.Objective)
+	headers := generateColumnHeaders(numVariables, len(stdConstraints))
+	for j := 1; j <= numVariables; j++ {
+		headers[j] = variableName(req.VariableNames, j-1)
+	}
+	return method, headers, basicTableau{
+		tableau: buildInitialTableau(req.Objective, stdConstraints, stdRHS),
+		basis:   initialBasis(numVariables, len(stdConstraints)),
+	}, nil
+}
+
+func (s *PivotSession) current() basicTableau {
+	return s.history[len(s.history)-1]
+}
+
+// nextPivot aplica la regla del solver a la tabla: devuelve el estado final (vacío
+// si todavía hay pivotes) y el pivote que elegiría el solver
+func nextPivot(method string, tableau models.SimplexTableau) (models.Status, int, int) {
+	if method == "dual" {
+		row, err := findDualPivotRow(tableau)
+		if err != nil {
+			return models.StatusOptimal, -1, -1
+		}
+		col, err := findDualPivotColumn(tableau, row)
+		if err != nil {
+			return models.StatusInfeasible, row, -1
+		}
+		return "", row, col
+	}
+
+	// El primal parte de RHS >= 0; si no, el solver informa infactible
+	rhsCol := len(tableau[0]) - 1
+	for i := 1; i < len(tableau); i++ {
+		if tableau[i][rhsCol] < -1e-9 {
+			return models.StatusInfeasible, -1, -1
+		}
+	}
+	col, err := findPivotColumn(tableau)
+	if err != nil {
+		return models.StatusOptimal, -1, -1
+	}
+	row, err := findPivotRow(tableau, col)
+	if err != nil {
+		return models.StatusUnbounded, -1, col
+	}
+	return "", row, col
+}
+
+// State devuelve la tabla actual y, si es final, su estado y solución
+func (s *PivotSession) State() models.SessionResponse {
+	current := s.current()
+	response := models.SessionResponse{
+		Method:    s.method,
+		Iteration: len(s.history) - 1,
+		Tableau:   newTableauStep(s.headers, current.tableau, current.basis),
+		CanUndo:   len(s.history) > 1,
+	}
+
+	state, _, _ := nextPivot(s.method, current.tableau)
+	switch state {
+	case "":
+		response.Message = i18n.Message(s.lang, i18n.SessionInProgress)
+		return response
+	case models.StatusOptimal:
+		optimal := response.Tableau.Objective
+		response.Optimal = &optimal
+		response.Variables = basicSolution(s.headers, s.numVariables, current.tableau, current.basis).Variables
+		response.Message = i18n.Message(s.lang, i18n.StatusOptimal)
+	case models.StatusUnbounded:
+		response.Message = i18n.Message(s.lang, i18n.StatusUnbounded)
+	case models.StatusInfeasible:
+		response.Message = i18n.Message(s.lang, i18n.StatusInfeasible)
+	}
+	response.Finished = true
+	response.Status = state
+	return response
+}
+
+// Pivot evalúa el pivote elegido (fila y columna de la tabla actual) y, si es válido
+// para el método, lo aplica. La respuesta incluye la evaluación y la tabla resultante.
+func (s *PivotSession) Pivot(row, col int) models.SessionResponse {
+	state, ruleRow, ruleCol := nextPivot(s.method, s.current().tableau)
+	if state != "" {
+		response := s.State()
+		response.Feedback = &models.PivotFeedback{Message: i18n.Message(s.lang, i18n.SessionFinished)}
+		return response
+	}
+
+	current := s.current()
+	suggested := pivotChoice(s.headers, current.basis, ruleRow, ruleCol)
+	feedback := &models.PivotFeedback{Suggested: &suggested}
+	if reason := pivotError(s.method, s.headers, current, row, col, s.lang); reason != "" {
+		feedback.Message = reason
+		response := s.State()
+		response.Feedback = feedback
+		return response
+	}
+
+	chosen := pivotChoice(s.headers, current.basis, row, col)
+	feedback.Valid = true
+	feedback.MatchesRule = matchesRule(s.method, current.tableau, row, col, ruleRow, ruleCol)
+	if feedback.MatchesRule {
+		feedback.Message = i18n.Message(s.lang, i18n.SessionPivotMatches, chosen.Entering, chosen.Leaving)
+	} else {
+		feedback.Message = i18n.Message(s.lang, i18n.SessionPivotDiffers, chosen.Entering, chosen.Leaving, suggested.Entering, suggested.Leaving)
+	}
+	if pivotRatio(s.method, current.tableau, row, col) < 1e-9 {
+		feedback.Message += " " + i18n.Message(s.lang, i18n.ExplainDegenerate)
+	}
+
+	basis := slices.Clone(current.basis)
+	basis[row-1] = col
+	s.history = append(s.history, basicTableau{tableau: pivot(current.tableau, row, col), basis: basis})
+
+	response := s.State()
+	response.Feedback = feedback
+	return response
+}
+
+// Undo deshace el último pivote
+func (s *PivotSession) Undo() error {
+	if len(s.history) == 1 {
+		return ErrNothingToUndo
+	}
+	s.history = s.history[:len(s.history)-1]
+	return nil
+}
+
+// pivotChoice arma el pivote con los nombres de las variables que entran y salen
+func pivotChoice(headers []string, basis []int, row, col int) models.PivotChoice {
+	choice := models.PivotChoice{Row: row, Col: col}
+	if col > 0 && col < len(headers) {
+		choice.Entering = headers[col]
+	}
+	if row > 0 && row <= len(basis) {
+		choice.Leaving = headers[basis[row-1]]
+	}
+	return choice
+}
+
+// matchesRule indica si un pivote válido es tan bueno como el de la regla del solver
+// (los empates cuentan): misma fila Z en el primal, mismo RHS en el dual
+func matchesRule(method string, tableau models.SimplexTableau, row, col, ruleRow, ruleCol int) bool {
+	if method == "dual" {
+		rhsCol := len(tableau[0]) - 1
+		return math.Abs(tableau[row][rhsCol]-tableau[ruleRow][rhsCol]) < 1e-9
+	}
+	return math.Abs(tableau[Z_ROW_INDEX][col]-tableau[Z_ROW_INDEX][ruleCol]) < 1e-9
+}
+
+// pivotRatio es el cociente del pivote según el método (0 indica un pivote degenerado)
+func pivotRatio(method string, tableau models.SimplexTableau, row, col int) float64 {
+	if method == "dual" {
+		return dualRatioTest(tableau, row)[col]
+	}
+	return ratioTest(tableau, col)[row]
+}
+
+// pivotError devuelve por qué el pivote no es válido para el método (vacío si lo es)
+func pivotError(method string, headers []string, current basicTableau, row, col int, lang string) string {
+	tableau := current.tableau
+	rhsCol := len(tableau[0]) - 1
+
+	if row < 1 || row >= len(tableau) || col < 1 || col >= rhsCol {
+		return i18n.Message(lang, i18n.SessionPivotOutOfRange, row, col)
+	}
+	if slices.Contains(current.basis, col) {
+		return i18n.Message(lang, i18n.SessionPivotBasic, headers[col])
+	}
+
+	entering := headers[col]
+	leaving := headers[current.basis[row-1]]
+	element := formatValue(truncate(tableau[row][col]))
+
+	if method == "dual" {
+		if tableau[row][rhsCol] >= -1e-9 {
+			return i18n.Message(lang, i18n.SessionDualRowFeasible, leaving, formatValue(truncate(tableau[row][rhsCol])))
+		}
+		if tableau[row][col] >= -1e-9 {
+			return i18n.Message(lang, i18n.SessionDualNonNegative, element)
+		}
+		ratios := dualRatioTest(tableau, row)
+		if best := slices.Min(ratios); ratios[col]-best > 1e-9 {
+			return i18n.Message(lang, i18n.SessionDualNotMinRatio, entering, formatValue(truncate(ratios[col])), formatValue(truncate(best)))
+		}
+		return ""
+	}
+
+	if tableau[Z_ROW_INDEX][col] >= -1e-9 {
+		return i18n.Message(lang, i18n.SessionPivotNotImproving, entering, formatValue(truncate(tableau[Z_ROW_INDEX][col])))
+	}
+	if tableau[row][col] <= 1e-9 {
+		return i18n.Message(lang, i18n.SessionPivotNonPositive, element)
+	}
+	ratios := ratioTest(tableau, col)
+	if best := slices.Min(ratios); ratios[row]-best > 1e-9 {
+		return i18n.Message(lang, i18n.SessionPivotNotMinRatio, leaving, formatValue(truncate(ratios[row])), formatValue(truncate(best)))
+	}
+	return ""
+}
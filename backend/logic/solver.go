@@ -15,7 +15,7 @@ func SolveSimplexMaxWithTypes(objective []float64, constraints [][]float64, rhs
 	// 1. Preprocesar y estandarizar a <=
 	stdConstraints, stdRHS, err := StandardizeConstraints(constraints, rhs, types)
 	if err != nil {
-		return models.SimplexResponse{Status: "error: " + err.Error()}
+		return errorResponse(err)
 	}
 
 	// 2. Ejecutar Simplex Primal (Implementación propia)
@@ -44,7 +44,7 @@ func SolveSimplexMinWithTypes(objective []float64, constraints [][]float64, rhs
 	// 1. Preprocesar y estandarizar a <=
 	stdConstraints, stdRHS, err := StandardizeConstraints(constraints, rhs, types)
 	if err != nil {
-		return models.SimplexResponse{Status: "error: " + err.Error()}
+		return errorResponse(err)
 	}
 
 	// 2. Determinar si se usa Primal o Dual
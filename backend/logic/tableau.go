@@ -2,6 +2,7 @@ package logic
 
 import (
 	"fmt"
+	"math"
 
 	"proyecto/simplex/models"
 )
@@ -71,3 +72,148 @@ func pivot(tableau models.SimplexTableau, pivotRow, pivotCol int) models.Simplex
 
 	return newTableau
 }
+
+// initialBasis devuelve la base inicial: la columna de la holgura de cada fila de restricción
+func initialBasis(numVariables, numConstraints int) []int {
+	basis := make([]int, numConstraints)
+	for i := range basis {
+		basis[i] = numVariables + 1 + i
+	}
+	return basis
+}
+
+// truncate trunca un valor a dos decimales para la visualización
+func truncate(v float64) float64 {
+	return math.Trunc(v*100) / 100
+}
+
+// roundValue redondea los errores de punto flotante (1.9999999 es 2) antes de truncar
+func roundValue(v float64) float64 {
+	return truncate(math.Round(v*1e6)/1e6) + 0
+}
+
+// newTableauStep arma un paso del historial: copia truncada de la tabla (y la copia
+// exacta), variable básica de cada fila (la fila Z se rotula "Z") y valor actual de
+// la función objetivo
+func newTableauStep(headers []string, tableau models.SimplexTableau, basis []int) models.TableauStep {
+	truncated := copyTableau(tableau)
+	for i, row := range truncated {
+		for j, val := range row {
+			truncated[i][j] = truncate(val)
+		}
+	}
+
+	basisNames := make([]string, 0, len(basis)+1)
+	basisNames = append(basisNames, headers[0])
+	for _, col := range basis {
+		basisNames = append(basisNames, headers[col])
+	}
+
+	rhsCol := len(tableau[0]) - 1
+	return models.TableauStep{
+		Headers:         headers,
+		Matrix:          truncated,
+		Exact:           copyTableau(tableau),
+		Basis:           basisNames,
+		Objective:       truncate(tableau[Z_ROW_INDEX][rhsCol]),
+		DegenerateBasis: isDegenerateBasis(tableau, basis),
+	}
+}
+
+// isDegenerateBasis indica si alguna variable básica vale 0 en la tabla
+func isDegenerateBasis(tableau models.SimplexTableau, basis []int) bool {
+	rhsCol := len(tableau[0]) - 1
+	for i := range basis {
+		if math.Abs(tableau[i+1][rhsCol]) < 1e-9 {
+			return true
+		}
+	}
+	return false
+}
+
+// markDegeneratePivot marca el paso si el cociente del pivote elegido es 0: la base
+// cambia pero la solución y la función objetivo no
+func markDegeneratePivot(response *models.SimplexResponse, step *models.TableauStep, ratio float64) {
+	if math.Abs(ratio) < 1e-9 {
+		step.DegeneratePivot = true
+		response.DegenerateIterations++
+	}
+}
+
+// recordPivot anota en el paso la decisión de pivoteo tomada sobre su tabla
+func recordPivot(step *models.TableauStep, tableau models.SimplexTableau, basis []int, pivotRow, pivotCol int) {
+	step.Entering = step.Headers[pivotCol]
+	step.Leaving = step.Headers[basis[pivotRow-1]]
+	step.PivotRow = pivotRow
+	step.PivotCol = pivotCol
+	step.PivotValue = truncate(tableau[pivotRow][pivotCol])
+}
+
+// truncatedRatios convierte los cocientes a la forma del historial (truncados, nil si no aplica)
+func truncatedRatios(ratios []float64) []*float64 {
+	out := make([]*float64, len(ratios))
+	for i, r := range ratios {
+		if math.IsInf(r, 0) || math.IsNaN(r) {
+			continue
+		}
+		v := truncate(r)
+		out[i] = &v
+	}
+	return out
+}
+
+// setStatus fija el estado tipado de la respuesta y su texto v1 ("error: ..." para
+// los errores; el límite de iteraciones se informa como "error: unknown" y el óptimo
+// de una función objetivo constante como "optimal (degenerate: multiple solutions)")
+func setStatus(response *models.SimplexResponse, status models.Status, err error) {
+	response.State = status
+	response.Err = err
+	switch {
+	case status == models.StatusError:
+		response.Status = "error: " + err.Error()
+	case status == models.StatusIterationLimit:
+		response.Status = "error: unknown"
+	case status == models.StatusOptimal && response.ConstantObjective:
+		response.Status = "optimal (degenerate: multiple solutions)"
+	default:
+		response.Status = string(status)
+	}
+}
+
+// errorResponse arma la respuesta de un modelo que no se pudo resolver
+func errorResponse(err error) models.SimplexResponse {
+	var response models.SimplexResponse
+	setStatus(&response, models.StatusError, err)
+	return response
+}
+
+// markSolutionFlags guarda la tabla óptima sin truncar y marca si la solución es
+// degenerada (una variable básica vale 0) y si hay óptimos alternativos (variables
+// no básicas con costo reducido 0 en la fila Z)
+func markSolutionFlags(response *models.SimplexResponse, headers []string, tableau models.SimplexTableau, basis []int) {
+	response.FinalTableau = copyTableau(tableau)
+	response.FinalBasis = append([]int(nil), basis...)
+
+	response.Degenerate = isDegenerateBasis(tableau, basis)
+	for _, j := range zeroReducedCostColumns(tableau, basis) {
+		response.AlternativeOptima = true
+		response.ZeroReducedCost = append(response.ZeroReducedCost, headers[j])
+	}
+}
+
+// zeroReducedCostColumns devuelve las columnas no básicas con costo reducido 0: al
+// hacerlas entrar la función objetivo no cambia
+func zeroReducedCostColumns(tableau models.SimplexTableau, basis []int) []int {
+	rhsCol := len(tableau[0]) - 1
+	isBasic := make(map[int]bool, len(basis))
+	for _, col := range basis {
+		isBasic[col] = true
+	}
+	var cols []int
+	for j := 1; j < rhsCol; j++ {
+		if !isBasic[j] && math.Abs(tableau[Z_ROW_INDEX][j]) < 1e-9 {
+			cols = append(cols, j)
+		}
+	}
+	return cols
+}
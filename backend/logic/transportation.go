@@ -0,0 +1,443 @@
+package logic
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+
+	"proyecto/simplex/i18n"
+	"proyecto/simplex/models"
+)
+
+// maxTransportIterations limita las iteraciones de MODI (con soluciones degeneradas
+// el método puede ciclar)
+const maxTransportIterations = 1000
+
+// SolveTransportation resuelve el problema de transporte: lo balancea con un origen o
+// destino ficticio, arma la solución inicial con el método pedido y la mejora con
+// MODI (potenciales u_i + v_j = c_ij en las rutas básicas) moviendo envíos por el
+// ciclo de stepping-stone de la ruta que entra, hasta que ningún costo reducido es
+// negativo. Devuelve cada asignación del método inicial y cada tabla de MODI.
+func SolveTransportation(req models.TransportationRequest) (models.TransportationResponse, error) {
+	if err := validateTransportation(req); err != nil {
+		return models.TransportationResponse{}, err
+	}
+	lang := req.Language
+	response := balanceTransportation(req)
+	response.Method = req.Method
+	if response.Method == "" {
+		response.Method = models.TransportNorthwest
+	}
+
+	costs := response.Costs
+	allocation, basis, initial := initialAllocation(response.Method, response.Supply, response.Demand, costs)
+	response.Initial = initial
+
+	tol := transportTolerance(costs)
+	response.Status = models.StatusIterationLimit
+	for iteration := 0; iteration <= maxTransportIterations; iteration++ {
+		step, reduced := transportationStep(allocation, basis, costs)
+		var entering models.TransportCell
+		found, best := false, -tol
+		for i, row := range reduced {
+			for j, d := range row {
+				if !math.IsNaN(d) && d < best {
+					entering, best, found = models.TransportCell{Row: i, Col: j}, d, true
+				}
+			}
+		}
+		if !found {
+			response.Status = models.StatusOptimal
+			for _, row := range reduced {
+				response.AlternativeOptima = response.AlternativeOptima || slices.ContainsFunc(row, func(d float64) bool { return math.Abs(d) <= tol })
+			}
+			response.Steps = append(response.Steps, step)
+			break
+		}
+		if iteration == maxTransportIterations {
+			response.Steps = append(response.Steps, step)
+			break
+		}
+
+		// Ciclo de stepping-stone: se mueve theta, el menor envío de las rutas con signo -
+		cycle := steppingStone(basis, entering, len(allocation))
+		leaving := 1
+		for k := 3; k < len(cycle); k += 2 {
+			if allocation[cycle[k].Row][cycle[k].Col] < allocation[cycle[leaving].Row][cycle[leaving].Col] {
+				leaving = k
+			}
+		}
+		theta := allocation[cycle[leaving].Row][cycle[leaving].Col]
+		for k, cell := range cycle {
+			if k%2 == 0 {
+				allocation[cell.Row][cell.Col] += theta
+			} else {
+				allocation[cell.Row][cell.Col] -= theta
+			}
+		}
+		allocation[cycle[leaving].Row][cycle[leaving].Col] = 0
+
+		step.Entering, step.Leaving = &entering, &cycle[leaving]
+		step.Cycle, step.Theta = cycle, truncate(theta)
+		response.Steps = append(response.Steps, step)
+		basis[slices.Index(basis, cycle[leaving])] = entering
+	}
+
+	final := response.Steps[len(response.Steps)-1]
+	response.Allocation = final.Allocation
+	response.TotalCost = final.Cost
+	for _, cell := range basis {
+		response.Degenerate = response.Degenerate || allocation[cell.Row][cell.Col] == 0
+	}
+
+	if response.Status == models.StatusOptimal {
+		response.Message = i18n.Message(lang, i18n.TransportOptimal, formatValue(response.TotalCost), len(response.Steps)-1)
+	} else {
+		response.Message = i18n.Message(lang, i18n.StatusIterationLimit)
+	}
+	switch response.Dummy {
+	case "source":
+		response.Message += " " + i18n.Message(lang, i18n.TransportDummySourceAdded, formatValue(response.Supply[len(response.Supply)-1]))
+	case "destination":
+		response.Message += " " + i18n.Message(lang, i18n.TransportDummyDestinationAdded, formatValue(response.Demand[len(response.Demand)-1]))
+	}
+	return response, nil
+}
+
+// validateTransportation verifica oferta, demanda, matriz de costos y método, y
+// devuelve todos los problemas encontrados
+func validateTransportation(req models.TransportationRequest) error {
+	var errs ValidationErrors
+	amounts := func(field string, values []float64, empty i18n.Code) {
+		if len(values) == 0 {
+			errs = append(errs, newFieldError(field, empty))
+		}
+		for i, v := range values {
+			if !isFinite(v) || v < 0 {
+				fe := newFieldError(fmt.Sprintf("%s[%d]", field, i), i18n.TransportInvalidAmount)
+				fe.Details = map[string]any{"value": fmt.Sprint(v)}
+				errs = append(errs, fe)
+			}
+		}
+	}
+	amounts("supply", req.Supply, i18n.TransportEmptySupply)
+	amounts("demand", req.Demand, i18n.TransportEmptyDemand)
+
+	if len(req.Costs) != len(req.Supply) {
+		fe := newFieldError("costs", i18n.TransportCostRows)
+		fe.Details = map[string]any{"expected": len(req.Supply), "got": len(req.Costs)}
+		errs = append(errs, fe)
+	}
+	for i, row := range req.Costs {
+		if len(row) != len(req.Demand) {
+			fe := newFieldError(fmt.Sprintf("costs[%d]", i), i18n.TransportCostColumns)
+			fe.Details = map[string]any{"expected": len(req.Demand), "got": len(row)}
+			errs = append(errs, fe)
+		}
+		for j, v := range row {
+			if !isFinite(v) {
+				errs = append(errs, nonFinite(fmt.Sprintf("costs[%d][%d]", i, j), i18n.TransportNonFiniteCost, v))
+			}
+		}
+	}
+
+	switch req.Method {
+	case "", models.TransportNorthwest, models.TransportLeastCost, models.TransportVogel:
+	default:
+		errs = append(errs, newFieldError("method", i18n.TransportUnknownMethod, req.Method))
+	}
+	return errs.orNil()
+}
+
+// balanceTransportation copia el problema y, si la oferta total no coincide con la
+// demanda, agrega un origen o destino ficticio con la diferencia y costo 0
+func balanceTransportation(req models.TransportationRequest) models.TransportationResponse {
+	response := models.TransportationResponse{
+		Supply: slices.Clone(req.Supply),
+		Demand: slices.Clone(req.Demand),
+	}
+	for i, row := range req.Costs {
+		response.Costs = append(response.Costs, slices.Clone(row))
+		response.Sources = append(response.Sources, transportName(req.SourceNames, "O", i))
+	}
+	for j := range req.Demand {
+		response.Destinations = append(response.Destinations, transportName(req.DestinationNames, "D", j))
+	}
+
+	supply, demand := sum(req.Supply), sum(req.Demand)
+	switch {
+	case supply-demand > tolerance(supply, demand):
+		response.Dummy = "destination"
+		response.Demand = append(response.Demand, supply-demand)
+		response.Destinations = append(response.Destinations, i18n.Message(req.Language, i18n.TransportDummyDestination))
+		for i := range response.Costs {
+			response.Costs[i] = append(response.Costs[i], 0)
+		}
+	case demand-supply > tolerance(supply, demand):
+		response.Dummy = "source"
+		response.Supply = append(response.Supply, demand-supply)
+		response.Sources = append(response.Sources, i18n.Message(req.Language, i18n.TransportDummySource))
+		response.Costs = append(response.Costs, make([]float64, len(response.Demand)))
+	}
+	return response
+}
+
+func transportName(names []string, prefix string, i int) string {
+	if i < len(names) && names[i] != "" {
+		return names[i]
+	}
+	return fmt.Sprintf("%s%d", prefix, i+1)
+}
+
+func sum(values []float64) float64 {
+	total := 0.0
+	for _, v := range values {
+		total += v
+	}
+	return total
+}
+
+// transportTolerance es la tolerancia de los costos reducidos, relativa al mayor costo
+func transportTolerance(costs [][]float64) float64 {
+	var values []float64
+	for _, row := range costs {
+		values = append(values, row...)
+	}
+	return tolerance(values...)
+}
+
+// initialAllocation arma la solución inicial con el método pedido. Cada asignación
+// tacha una fila o una columna (las dos solo en la última), así la solución tiene
+// m+n-1 rutas básicas aunque alguna lleve 0 (solución degenerada).
+func initialAllocation(method string, supply, demand []float64, costs [][]float64) ([][]float64, []models.TransportCell, []models.InitialAllocation) {
+	m, n := len(supply), len(demand)
+	remainingSupply, remainingDemand := slices.Clone(supply), slices.Clone(demand)
+	rowOpen, colOpen := make([]bool, m), make([]bool, n)
+	for i := range rowOpen {
+		rowOpen[i] = true
+	}
+	for j := range colOpen {
+		colOpen[j] = true
+	}
+	openRows, openCols := m, n
+	zero := tolerance(sum(supply))
+
+	allocation := make([][]float64, m)
+	for i := range allocation {
+		allocation[i] = make([]float64, n)
+	}
+	var basis []models.TransportCell
+	var steps []models.InitialAllocation
+	for openRows > 0 && openCols > 0 {
+		var step models.InitialAllocation
+		switch method {
+		case models.TransportLeastCost:
+			step.TransportCell = cheapestCell(costs, rowOpen, colOpen, remainingSupply, remainingDemand)
+		case models.TransportVogel:
+			step = vogelChoice(costs, rowOpen, colOpen)
+		default:
+			step.TransportCell = models.TransportCell{Row: slices.Index(rowOpen, true), Col: slices.Index(colOpen, true)}
+		}
+
+		i, j := step.Row, step.Col
+		amount := math.Min(remainingSupply[i], remainingDemand[j])
+		allocation[i][j] = amount
+		remainingSupply[i] -= amount
+		remainingDemand[j] -= amount
+		step.Amount = truncate(amount)
+		basis = append(basis, step.TransportCell)
+		steps = append(steps, step)
+
+		switch {
+		case openRows == 1 && openCols == 1:
+			rowOpen[i], colOpen[j] = false, false
+			openRows, openCols = 0, 0
+		case remainingSupply[i] <= zero && openRows > 1:
+			rowOpen[i] = false
+			openRows--
+		default:
+			colOpen[j] = false
+			openCols--
+		}
+	}
+	return allocation, basis, steps
+}
+
+// cheapestCell elige la ruta abierta de menor costo; con empates, la que permite
+// enviar más
+func cheapestCell(costs [][]float64, rowOpen, colOpen []bool, supply, demand []float64) models.TransportCell {
+	best := models.TransportCell{Row: -1}
+	for i, row := range costs {
+		for j, c := range row {
+			if !rowOpen[i] || !colOpen[j] {
+				continue
+			}
+			if best.Row < 0 || c < costs[best.Row][best.Col] ||
+				(c == costs[best.Row][best.Col] && math.Min(supply[i], demand[j]) > math.Min(supply[best.Row], demand[best.Col])) {
+				best = models.TransportCell{Row: i, Col: j}
+			}
+		}
+	}
+	return best
+}
+
+// vogelChoice calcula la penalización de cada fila y columna abierta (diferencia entre
+// sus dos menores costos, o el costo si queda uno) y elige la ruta de menor costo de la
+// línea con mayor penalización (con empates, la de ruta más barata)
+func vogelChoice(costs [][]float64, rowOpen, colOpen []bool) models.InitialAllocation {
+	step := models.InitialAllocation{
+		RowPenalties:    make([]*float64, len(rowOpen)),
+		ColumnPenalties: make([]*float64, len(colOpen)),
+	}
+	bestPenalty, bestCost := -1.0, 0.0
+	consider := func(line []models.TransportCell, penalties []*float64, k int) {
+		var values []float64
+		for _, cell := range line {
+			values = append(values, costs[cell.Row][cell.Col])
+		}
+		slices.Sort(values)
+		penalty := values[0]
+		if len(values) > 1 {
+			penalty = values[1] - values[0]
+		}
+		p := truncate(penalty)
+		penalties[k] = &p
+
+		if penalty > bestPenalty || (penalty == bestPenalty && values[0] < bestCost) {
+			bestPenalty, bestCost = penalty, values[0]
+			step.TransportCell = slices.MinFunc(line, func(a, b models.TransportCell) int {
+				return cmp.Compare(costs[a.Row][a.Col], costs[b.Row][b.Col])
+			})
+		}
+	}
+
+	for i, open := range rowOpen {
+		if !open {
+			continue
+		}
+		var line []models.TransportCell
+		for j := range colOpen {
+			if colOpen[j] {
+				line = append(line, models.TransportCell{Row: i, Col: j})
+			}
+		}
+		consider(line, step.RowPenalties, i)
+	}
+	for j, open := range colOpen {
+		if !open {
+			continue
+		}
+		var line []models.TransportCell
+		for i := range rowOpen {
+			if rowOpen[i] {
+				line = append(line, models.TransportCell{Row: i, Col: j})
+			}
+		}
+		consider(line, step.ColumnPenalties, j)
+	}
+	return step
+}
+
+// transportationStep calcula los potenciales y los costos reducidos de la asignación
+// (NaN en las rutas básicas) y arma la tabla para la respuesta
+func transportationStep(allocation [][]float64, basis []models.TransportCell, costs [][]float64) (models.TransportationStep, [][]float64) {
+	m, n := len(costs), len(costs[0])
+	u, v := make([]float64, m), make([]float64, n)
+	knownU, knownV := make([]bool, m), make([]bool, n)
+	knownU[0] = true
+	for changed := true; changed; {
+		changed = false
+		for _, cell := range basis {
+			c := costs[cell.Row][cell.Col]
+			switch {
+			case knownU[cell.Row] && !knownV[cell.Col]:
+				v[cell.Col], knownV[cell.Col], changed = c-u[cell.Row], true, true
+			case knownV[cell.Col] && !knownU[cell.Row]:
+				u[cell.Row], knownU[cell.Row], changed = c-v[cell.Col], true, true
+			}
+		}
+	}
+
+	step := models.TransportationStep{Basis: slices.Clone(basis)}
+	reduced := make([][]float64, m)
+	cost := 0.0
+	for i := range costs {
+		reduced[i] = make([]float64, n)
+		row := make([]float64, n)
+		reducedRow := make([]*float64, n)
+		for j, c := range costs[i] {
+			row[j] = truncate(allocation[i][j]) + 0
+			cost += allocation[i][j] * c
+			if slices.Contains(basis, models.TransportCell{Row: i, Col: j}) {
+				reduced[i][j] = math.NaN()
+				continue
+			}
+			reduced[i][j] = c - u[i] - v[j]
+			d := truncate(reduced[i][j]) + 0
+			reducedRow[j] = &d
+		}
+		step.Allocation = append(step.Allocation, row)
+		step.ReducedCosts = append(step.ReducedCosts, reducedRow)
+	}
+	for i := range u {
+		step.U = append(step.U, truncate(u[i])+0)
+	}
+	for j := range v {
+		step.V = append(step.V, truncate(v[j])+0)
+	}
+	step.Cost = truncate(cost) + 0
+	return step, reduced
+}
+
+// steppingStone arma el ciclo de la ruta que entra: el camino del árbol de rutas
+// básicas (nodos: filas 0..m-1 y columnas m..m+n-1) que va de su columna a su fila,
+// precedido por la ruta que entra
+func steppingStone(basis []models.TransportCell, entering models.TransportCell, m int) []models.TransportCell {
+	node := func(cell models.TransportCell, row bool) int {
+		if row {
+			return cell.Row
+		}
+		return m + cell.Col
+	}
+	start, target := node(entering, false), node(entering, true)
+
+	// Búsqueda en anchura guardando la ruta por la que se llegó a cada nodo
+	previous := map[int]int{start: -1}
+	queue := []int{start}
+	for len(queue) > 0 {
+		if _, found := previous[target]; found {
+			break
+		}
+		current := queue[0]
+		queue = queue[1:]
+		for k, cell := range basis {
+			var next int
+			switch current {
+			case node(cell, true):
+				next = node(cell, false)
+			case node(cell, false):
+				next = node(cell, true)
+			default:
+				continue
+			}
+			if _, seen := previous[next]; !seen {
+				previous[next] = k + 1
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	var path []models.TransportCell
+	for current := target; current != start; {
+		cell := basis[previous[current]-1]
+		path = append(path, cell)
+		if current == node(cell, true) {
+			current = node(cell, false)
+		} else {
+			current = node(cell, true)
+		}
+	}
+	slices.Reverse(path)
+	return append([]models.TransportCell{entering}, path...)
+}
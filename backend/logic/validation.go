@@ -1,54 +1,160 @@
 package logic
 
 import (
-	"errors"
+	"fmt"
 	"math"
+	"strings"
+
+	"proyecto/simplex/i18n"
 )
 
-// ValidarEntrada verifica que los datos sean correctos para el método simplex
+// FieldError es un problema de validación en un campo del modelo. Field es la ruta
+// del campo en el JSON (p. ej. "constraints[2][1]") y Details agrega datos para el
+// cliente (longitud esperada, valor recibido...). Los errores de características
+// no soportadas se crean con newUnsupportedError, que los marca para responder 422.
+type FieldError struct {
+	Field   string
+	Err     *i18n.Error
+	Details map[string]any
+
+	unsupported bool
+}
+
+func newFieldError(field string, code i18n.Code, args ...any) *FieldError {
+	return &FieldError{Field: field, Err: i18n.New(code, args...)}
+}
+
+// newUnsupportedError crea el error de un campo que usa una característica que el
+// solver no soporta
+func newUnsupportedError(field string, err *i18n.Error) *FieldError {
+	return &FieldError{Field: field, Err: err, unsupported: true}
+}
+
+func (e *FieldError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// Unsupported indica si el error corresponde a una característica que el solver no
+// soporta (el modelo es válido pero no se puede resolver con este método)
+func (e *FieldError) Unsupported() bool {
+	return e.unsupported
+}
+
+// ValidationErrors agrupa todos los problemas encontrados al validar un modelo
+type ValidationErrors []*FieldError
+
+func (e ValidationErrors) Error() string {
+	return e.Localize(i18n.DefaultLanguage)
+}
+
+// Localize une los mensajes de todos los errores en el idioma pedido
+func (e ValidationErrors) Localize(lang string) string {
+	messages := make([]string, len(e))
+	for i, fe := range e {
+		messages[i] = i18n.Localize(fe.Err, lang)
+	}
+	return strings.Join(messages, "; ")
+}
+
+func (e ValidationErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, fe := range e {
+		errs[i] = fe
+	}
+	return errs
+}
+
+// Unsupported indica si todos los errores son de características no soportadas
+func (e ValidationErrors) Unsupported() bool {
+	for _, fe := range e {
+		if !fe.Unsupported() {
+			return false
+		}
+	}
+	return len(e) > 0
+}
+
+// orNil devuelve nil si no hay errores (evita devolver un slice vacío como error)
+func (e ValidationErrors) orNil() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e
+}
+
+// ValidarEntrada verifica que los datos sean correctos para el método simplex.
+// Devuelve ValidationErrors con todos los problemas encontrados, no solo el primero.
 func ValidarEntrada(objective []float64, constraints [][]float64, rhs []float64) error {
+	var errs ValidationErrors
+
 	// Vacíos o nulos
 	if len(objective) == 0 {
-		return errors.New("vector objective no puede estar vacío")
+		errs = append(errs, newFieldError("objective", i18n.EmptyObjective))
 	}
 	if len(constraints) == 0 {
-		return errors.New("matriz constraints no puede estar vacía")
+		errs = append(errs, newFieldError("constraints", i18n.EmptyConstraints))
 	}
 	if len(rhs) == 0 {
-		return errors.New("vector rhs no puede estar vacío")
+		errs = append(errs, newFieldError("rhs", i18n.EmptyRHS))
 	}
 
-	// Dimensiones inconsistentes
-	rows := len(constraints)
-	cols := len(constraints[0])
-	for i := range constraints {
-		if len(constraints[i]) != cols {
-			return errors.New("todas las filas de constraints deben tener el mismo número de columnas")
+	// Dimensiones inconsistentes (solo si hay con qué comparar)
+	if len(constraints) > 0 {
+		rows := len(constraints)
+		cols := len(constraints[0])
+		for i := range constraints {
+			if len(constraints[i]) != cols {
+				fe := newFieldError(fmt.Sprintf("constraints[%d]", i), i18n.RaggedConstraints)
+				fe.Details = map[string]any{"expected": cols, "got": len(constraints[i])}
+				errs = append(errs, fe)
+			}
+		}
+		if len(rhs) > 0 && len(rhs) != rows {
+			fe := newFieldError("rhs", i18n.RHSLength)
+			fe.Details = map[string]any{"expected": rows, "got": len(rhs)}
+			errs = append(errs, fe)
+		}
+		if len(objective) > 0 && len(objective) != cols {
+			fe := newFieldError("objective", i18n.ObjectiveLength)
+			fe.Details = map[string]any{"expected": cols, "got": len(objective)}
+			errs = append(errs, fe)
 		}
-	}
-	if len(rhs) != rows {
-		return errors.New("la longitud de rhs no coincide con el número de filas de constraints")
-	}
-	if len(objective) != cols {
-		return errors.New("la longitud de objective no coincide con el número de columnas de constraints")
 	}
 
 	// Valores no finitos
-	for _, v := range objective {
-		if math.IsNaN(v) || math.IsInf(v, 0) {
-			return errors.New("objective contiene valores no finitos")
+	for j, v := range objective {
+		if !isFinite(v) {
+			errs = append(errs, nonFinite(fmt.Sprintf("objective[%d]", j), i18n.NonFiniteObjective, v))
 		}
 	}
-	for i := 0; i < rows; i++ {
-		for j := 0; j < cols; j++ {
-			if math.IsNaN(constraints[i][j]) || math.IsInf(constraints[i][j], 0) {
-				return errors.New("constraints contiene valores no finitos")
+	for i, row := range constraints {
+		for j, v := range row {
+			if !isFinite(v) {
+				errs = append(errs, nonFinite(fmt.Sprintf("constraints[%d][%d]", i, j), i18n.NonFiniteConstraints, v))
 			}
 		}
-		if math.IsNaN(rhs[i]) || math.IsInf(rhs[i], 0) {
-			return errors.New("rhs contiene valores no finitos")
+	}
+	for i, v := range rhs {
+		if !isFinite(v) {
+			errs = append(errs, nonFinite(fmt.Sprintf("rhs[%d]", i), i18n.NonFiniteRHS, v))
 		}
 	}
 
-	return nil
+	return errs.orNil()
+}
+
+func isFinite(v float64) bool {
+	return !math.IsNaN(v) && !math.IsInf(v, 0)
+}
+
+// nonFinite arma el error de un valor NaN o infinito (el valor va como texto porque
+// no se puede codificar en JSON)
+func nonFinite(field string, code i18n.Code, v float64) *FieldError {
+	fe := newFieldError(field, code)
+	fe.Details = map[string]any{"value": fmt.Sprint(v)}
+	return fe
 }
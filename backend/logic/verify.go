@@ -0,0 +1,169 @@
+package logic
+
+import (
+	"maps"
+	"math"
+	"slices"
+
+	"proyecto/simplex/i18n"
+	"proyecto/simplex/models"
+)
+
+// defaultVerifyTolerance admite las diferencias de una solución calculada con dos
+// decimales, como las tablas del solver
+const defaultVerifyTolerance = 0.01
+
+// VerifySolution verifica una solución calculada fuera del solver (otro software, un
+// ejercicio resuelto a mano): residuo de cada restricción, factibilidad, valor de la
+// función objetivo y optimalidad comparando con el óptimo del modelo. Con duales
+// calcula los costos reducidos y la brecha de dualidad, que certifican la
+// optimalidad si los duales son factibles y la brecha es 0.
+func VerifySolution(req models.VerifyRequest) (models.VerifyResponse, error) {
+	if errs := validateModel(req.SimplexRequest, true); len(errs) > 0 {
+		return models.VerifyResponse{}, errs
+	}
+
+	p := generalForm(req.SimplexRequest)
+	x, errs := valuesByName(req.Solution, p.variables, "solution", i18n.UnknownVariable)
+	y, dualErrs := valuesByName(req.Duals, p.rows, "duals", i18n.UnknownDual)
+	if errs = append(errs, dualErrs...); len(errs) > 0 {
+		return models.VerifyResponse{}, errs
+	}
+
+	tol := req.Tolerance
+	if tol <= 0 {
+		tol = defaultVerifyTolerance
+	}
+
+	objective := dot(p.objective, x)
+	response := models.VerifyResponse{Objective: truncate(objective) + 0}
+	response.Residuals = residuals(p, x, tol)
+	unsatisfied := 0
+	for _, r := range response.Residuals {
+		if !r.Satisfied {
+			unsatisfied++
+		}
+	}
+	response.Feasible = unsatisfied == 0
+
+	state, z, _ := solveLP(p)
+	response.State = state
+	if state == models.StatusOptimal {
+		optimal := truncate(z) + 0
+		response.OptimalValue = &optimal
+		response.Optimal = response.Feasible && math.Abs(objective-z) <= tol
+	}
+
+	dual := dualOf(p)
+	certified := false
+	switch {
+	case len(req.Duals) > 0:
+		response.DualsSource = "claimed"
+	case state == models.StatusOptimal:
+		response.DualsSource = "computed"
+		_, _, y = solveLP(dual)
+	default:
+		y = nil
+	}
+	if y != nil {
+		certified = addDualCertificate(&response, p, dual, y, objective, tol)
+	}
+
+	lang := req.Language
+	switch {
+	case !response.Feasible:
+		response.Message = i18n.Message(lang, i18n.VerifyInfeasible, unsatisfied)
+	case response.Optimal:
+		response.Message = i18n.Message(lang, i18n.VerifyOptimal, formatValue(response.Objective))
+	case state == models.StatusUnbounded:
+		response.Message = i18n.Message(lang, i18n.VerifyUnbounded, formatValue(response.Objective))
+	case state == models.StatusOptimal:
+		response.Message = i18n.Message(lang, i18n.VerifyNotOptimal, formatValue(response.Objective), formatValue(*response.OptimalValue))
+	default:
+		response.Message = i18n.Message(lang, i18n.VerifyUnknown)
+	}
+	if response.DualsSource == "claimed" && !certified {
+		response.Message += " " + i18n.Message(lang, i18n.VerifyDualsNotCertificate)
+	}
+	return response, nil
+}
+
+// valuesByName ordena los valores recibidos por nombre según names (los que faltan
+// valen 0). Los nombres desconocidos son errores en field.nombre.
+func valuesByName(values map[string]float64, names []string, field string, code i18n.Code) ([]float64, ValidationErrors) {
+	out := make([]float64, len(names))
+	var errs ValidationErrors
+	for _, name := range slices.Sorted(maps.Keys(values)) {
+		j := slices.Index(names, name)
+		switch {
+		case j < 0:
+			errs = append(errs, newFieldError(field+"."+name, code, name))
+		case !isFinite(values[name]):
+			errs = append(errs, nonFinite(field+"."+name, i18n.NonFiniteSolution, values[name]))
+		default:
+			out[j] = values[name]
+		}
+	}
+	return out, errs
+}
+
+// residuals evalúa cada restricción (incluidas las cotas) y el signo de cada variable,
+// admitiendo una diferencia de tol
+func residuals(p linearProgram, x []float64, tol float64) []models.ConstraintResidual {
+	var out []models.ConstraintResidual
+	add := func(name, kind string, lhs, rhs float64) {
+		r := lhs - rhs
+		satisfied := (kind == "le" && r <= tol) || (kind == "ge" && r >= -tol) || (kind == "eq" && math.Abs(r) <= tol)
+		out = append(out, models.ConstraintResidual{
+			Name:      name,
+			Type:      kind,
+			LHS:       truncate(lhs) + 0,
+			RHS:       rhs,
+			Residual:  truncate(r) + 0,
+			Satisfied: satisfied,
+		})
+	}
+
+	for i, row := range p.constraints {
+		add(p.rows[i], p.types[i], dot(row, x), p.rhs[i])
+	}
+	for j, s := range p.signs {
+		switch s {
+		case models.SignNonNegative:
+			add(p.variables[j], "ge", x[j], 0)
+		case models.SignNonPositive:
+			add(p.variables[j], "le", x[j], 0)
+		}
+	}
+	return out
+}
+
+// addDualCertificate completa los duales, los costos reducidos (c_j - a_j·y), la
+// factibilidad dual y la brecha de dualidad. Devuelve si los duales certifican que
+// la solución es óptima (con la misma tolerancia tol que la verificación).
+func addDualCertificate(response *models.VerifyResponse, primal, dual linearProgram, y []float64, objective, tol float64) bool {
+	response.Duals = make(map[string]float64, len(y))
+	for i, v := range y {
+		response.Duals[primal.rows[i]] = truncate(v) + 0
+	}
+
+	feasible := true
+	for _, r := range residuals(dual, y, tol) {
+		feasible = feasible && r.Satisfied
+	}
+	response.DualFeasible = feasible
+
+	response.ReducedCosts = make(map[string]float64, len(primal.variables))
+	for j, row := range dual.constraints {
+		response.ReducedCosts[primal.variables[j]] = truncate(primal.objective[j]-dot(row, y)) + 0
+	}
+
+	dualObjective := dot(dual.objective, y)
+	gap := objective - dualObjective
+	truncatedObjective := truncate(dualObjective) + 0
+	truncatedGap := truncate(gap) + 0
+	response.DualObjective = &truncatedObjective
+	response.DualityGap = &truncatedGap
+
+	return response.Feasible && feasible && math.Abs(gap) <= tol
+}
@@ -0,0 +1,144 @@
+package logic
+
+import (
+	"math"
+	"slices"
+
+	"proyecto/simplex/i18n"
+	"proyecto/simplex/models"
+)
+
+// maxVertexBases limita las bases que se prueban al enumerar vértices: con n variables
+// y m restricciones son C(n+m, m)
+const maxVertexBases = 20000
+
+// EnumerateVertices enumera los vértices de la región factible probando todas las
+// bases de la forma estándar que usa el solver (restricciones <= con una holgura por
+// fila): resuelve B x_B = b y se queda con las soluciones no negativas. También ubica
+// el vértice de cada tabla del historial del solver, para validar su recorrido.
+func EnumerateVertices(req models.SimplexRequest) (models.VertexEnumeration, error) {
+	_, headers, initial, err := initialTableau(req)
+	if err != nil {
+		return models.VertexEnumeration{}, err
+	}
+	tableau := initial.tableau
+	numRows := len(tableau) - 1
+	numColumns := len(headers) - 2 // variables y holguras
+	numVariables := len(req.Objective)
+	rhsCol := len(headers) - 1
+	if binomial(numColumns, numRows) > maxVertexBases {
+		return models.VertexEnumeration{}, newUnsupportedError("constraints", i18n.New(i18n.VerticesTooMany, numColumns, numRows, maxVertexBases))
+	}
+
+	rows := constraintRowNames(req)
+	response := models.VertexEnumeration{Variables: headers[1 : numVariables+1]}
+	var points [][]float64
+	combinations(numColumns, numRows, func(chosen []int) {
+		response.Bases++
+		a := make([][]float64, numRows)
+		b := make([]float64, numRows)
+		for i := range a {
+			a[i] = make([]float64, numRows)
+			for k, col := range chosen {
+				a[i][k] = tableau[i+1][col+1]
+			}
+			b[i] = tableau[i+1][rhsCol]
+		}
+		values, ok := solveSquare(a, b)
+		switch {
+		case !ok:
+			response.SingularBases++
+			return
+		case slices.ContainsFunc(values, func(v float64) bool { return v < -tolerance(v) }):
+			response.InfeasibleBases++
+			return
+		}
+
+		x := make([]float64, numColumns)
+		basis := make([]string, len(chosen))
+		for k, col := range chosen {
+			x[col] = values[k]
+			basis[k] = headers[col+1]
+		}
+		k := slices.IndexFunc(points, func(y []float64) bool { return samePoint(x, y) })
+		if k < 0 {
+			k = len(points)
+			points = append(points, x)
+			response.Vertices = append(response.Vertices, newVertex(req, headers, rows, x))
+		}
+		vertex := &response.Vertices[k]
+		vertex.Bases = append(vertex.Bases, basis)
+		vertex.Degenerate = vertex.Degenerate || slices.ContainsFunc(values, func(v float64) bool { return math.Abs(v) <= tolerance(v) })
+	})
+
+	for k, v := range response.Vertices {
+		switch {
+		case response.BestObjective == nil || v.Objective == *response.BestObjective:
+		case (req.Type == "max") == (v.Objective > *response.BestObjective):
+			response.BestVertices = nil
+		default:
+			continue
+		}
+		best := v.Objective
+		response.BestObjective = &best
+		response.BestVertices = append(response.BestVertices, k)
+	}
+
+	if result, err := SolveRequest(req); err == nil {
+		response.Method = result.Method
+		for k, step := range result.TableauxHistory {
+			response.Path = append(response.Path, vertexStep(k, step.Basis[1:], response.Vertices))
+		}
+	}
+	response.Message = i18n.Message(req.Language, i18n.VerticesFound, len(response.Vertices), response.Bases, response.SingularBases, response.InfeasibleBases)
+	return response, nil
+}
+
+// newVertex arma el vértice de la solución básica x (variables y holguras)
+func newVertex(req models.SimplexRequest, headers, rows []string, x []float64) models.Vertex {
+	numVariables := len(req.Objective)
+	vertex := models.Vertex{
+		Variables: make(map[string]float64, numVariables),
+		Slacks:    make(map[string]float64, len(rows)),
+		Objective: roundValue(dot(req.Objective, x[:numVariables])),
+	}
+	for i, name := range rows {
+		slack := x[numVariables+i]
+		vertex.Slacks[name] = roundValue(slack)
+		if math.Abs(slack) <= tolerance(slack) {
+			vertex.Active = append(vertex.Active, name)
+		}
+	}
+	for j := range numVariables {
+		vertex.Variables[headers[j+1]] = roundValue(x[j])
+		if math.Abs(x[j]) <= tolerance(x[j]) {
+			vertex.Active = append(vertex.Active, headers[j+1]+" >= 0")
+		}
+	}
+	return vertex
+}
+
+// vertexStep busca el vértice generado por la base de la tabla (en cualquier orden)
+func vertexStep(step int, basis []string, vertices []models.Vertex) models.VertexStep {
+	sorted := slices.Sorted(slices.Values(basis))
+	for k, v := range vertices {
+		for _, b := range v.Bases {
+			if slices.Equal(slices.Sorted(slices.Values(b)), sorted) {
+				return models.VertexStep{Step: step, Basis: basis, Vertex: &k}
+			}
+		}
+	}
+	return models.VertexStep{Step: step, Basis: basis}
+}
+
+// binomial calcula C(n, k); se detiene apenas supera maxVertexBases para no desbordar
+func binomial(n, k int) int {
+	result := 1
+	for i := 1; i <= k; i++ {
+		result = result * (n - k + i) / i
+		if result > maxVertexBases {
+			return result
+		}
+	}
+	return result
+}
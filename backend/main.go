@@ -19,6 +19,48 @@ func main() {
 
 	// Endpoint del simplex
 	r.POST("/api/simplex", handlers.SolveSimplexHandler)
+	// Respuesta v2: estado tipado, banderas y objeto de error
+	r.POST("/api/v2/simplex", handlers.SolveSimplexV2Handler)
+	// Modelo en notación algebraica ("max 3x + 5y subject to ...")
+	r.POST("/api/simplex/text", handlers.SolveTextHandler)
+	// Importación y exportación de modelos MPS
+	r.POST("/api/simplex/mps", handlers.SolveMPSHandler)
+	r.POST("/api/simplex/export/mps", handlers.ExportMPSHandler)
+	// Importación y exportación de modelos en formato CPLEX LP
+	r.POST("/api/simplex/lp", handlers.SolveLPHandler)
+	r.POST("/api/simplex/export/lp", handlers.ExportLPHandler)
+	// Importación de planillas CSV y XLSX
+	r.POST("/api/simplex/sheet", handlers.SolveSpreadsheetHandler)
+	// Exportación del historial de tablas a LaTeX
+	r.POST("/api/simplex/latex", handlers.LaTeXHandler)
+	// Reporte PDF de la solución
+	r.POST("/api/simplex/report", handlers.ReportHandler)
+	// Construcción del dual y verificación de dualidad fuerte y holgura complementaria
+	r.POST("/api/simplex/dual", handlers.DualHandler)
+	// Verificación de soluciones calculadas con otros solvers o a mano
+	r.POST("/api/simplex/verify", handlers.VerifyHandler)
+	// Modo interactivo: el usuario elige cada pivote y puede deshacer
+	r.POST("/api/simplex/sessions", handlers.CreateSessionHandler)
+	r.GET("/api/simplex/sessions/:id", handlers.GetSessionHandler)
+	r.POST("/api/simplex/sessions/:id/pivot", handlers.PivotSessionHandler)
+	r.POST("/api/simplex/sessions/:id/undo", handlers.UndoSessionHandler)
+	r.DELETE("/api/simplex/sessions/:id", handlers.DeleteSessionHandler)
+	// Corrección automática de las tablas de un alumno
+	r.POST("/api/simplex/grade", handlers.GradeHandler)
+	// Método gráfico para modelos de 2 o 3 variables (SVG en 2 variables)
+	r.POST("/api/simplex/graph", handlers.GraphHandler)
+	// Enumeración de los vértices (soluciones básicas factibles) probando todas las bases
+	r.POST("/api/simplex/vertices", handlers.VerticesHandler)
+	// Problema de transporte (solución inicial y MODI)
+	r.POST("/api/transportation", handlers.TransportationHandler)
+	// Problema de asignación (método húngaro)
+	r.POST("/api/assignment", handlers.AssignmentHandler)
+	// Flujo de costo mínimo, flujo máximo y camino más corto (simplex de redes)
+	r.POST("/api/network", handlers.NetworkHandler)
+	// Juegos de suma cero resueltos con programación lineal
+	r.POST("/api/game", handlers.GameHandler)
+	// Programación por metas (ponderada o lexicográfica)
+	r.POST("/api/goal", handlers.GoalHandler)
 	// Puerto dinámico para Render
 	port := os.Getenv("PORT")
 	if port == "" {
@@ -0,0 +1,64 @@
+package models
+
+// Pasos del método húngaro
+const (
+	AssignmentRowReduction    = "row_reduction"    // se resta el mínimo de cada fila
+	AssignmentColumnReduction = "column_reduction" // se resta el mínimo de cada columna
+	AssignmentCover           = "cover"            // mínimo de líneas que cubren los ceros
+	AssignmentAdjust          = "adjust"           // se resta el menor valor no cubierto
+)
+
+// AssignmentRequest es el cuerpo de /api/assignment: la matriz de costos (una fila por
+// agente y una columna por tarea) o de ganancias con type "max". Las matrices no
+// cuadradas se completan con filas o columnas ficticias de costo 0 y las celdas de
+// Forbidden no se pueden asignar.
+type AssignmentRequest struct {
+	Costs     [][]float64      `json:"costs"`
+	Type      string           `json:"type,omitempty"` // "min" (por defecto) o "max"
+	Forbidden []AssignmentCell `json:"forbidden,omitempty"`
+
+	RowNames    []string `json:"row_names,omitempty"`    // A1, A2... por defecto
+	ColumnNames []string `json:"column_names,omitempty"` // T1, T2... por defecto
+	Language    string   `json:"language,omitempty"`
+}
+
+// AssignmentCell es una celda de la matriz: fila y columna
+type AssignmentCell struct {
+	Row int `json:"row"`
+	Col int `json:"col"`
+}
+
+// AssignmentStep es un paso del método húngaro: la matriz reducida después del paso
+// (null en las celdas prohibidas), las líneas que cubren los ceros y, en los ajustes,
+// el menor valor no cubierto que se restó
+type AssignmentStep struct {
+	Kind           string       `json:"kind"`
+	Matrix         [][]*float64 `json:"matrix"`
+	CoveredRows    []int        `json:"covered_rows,omitempty"`
+	CoveredColumns []int        `json:"covered_columns,omitempty"`
+	Value          float64      `json:"value,omitempty"`
+	Description    string       `json:"description"`
+}
+
+// Assignment es un par agente-tarea de la solución con su costo (o ganancia) original
+type Assignment struct {
+	AssignmentCell
+	RowName    string  `json:"row_name"`
+	ColumnName string  `json:"column_name"`
+	Cost       float64 `json:"cost"`
+}
+
+// AssignmentResponse es la solución del problema de asignación. Los agentes asignados
+// a una tarea ficticia (o las tareas con un agente ficticio) quedan sin asignar.
+type AssignmentResponse struct {
+	Rows    []string     `json:"rows"`
+	Columns []string     `json:"columns"`
+	Matrix  [][]*float64 `json:"matrix"` // matriz cuadrada a minimizar
+
+	Steps       []AssignmentStep `json:"steps"`
+	Assignments []Assignment     `json:"assignments"`
+	Unassigned  []string         `json:"unassigned,omitempty"`
+	Total       float64          `json:"total"`
+	Status      Status           `json:"status"`
+	Message     string           `json:"message"`
+}
@@ -0,0 +1,50 @@
+package models
+
+// VariableSign es el signo de una variable del problema dual
+type VariableSign string
+
+const (
+	SignNonNegative VariableSign = "nonnegative"
+	SignNonPositive VariableSign = "nonpositive"
+	SignFree        VariableSign = "free"
+)
+
+// DualResponse es la respuesta de /api/simplex/dual. Dual tiene el mismo formato que
+// el cuerpo de /api/simplex (se puede volver a enviar): los signos de las variables
+// duales van como cotas (libre: {"free": true}, no positiva: {"free": true, "upper": 0})
+// y además se listan en VariableSigns.
+type DualResponse struct {
+	Dual          SimplexRequest `json:"dual"`
+	VariableSigns []VariableSign `json:"variable_signs"`
+	Check         *DualityCheck  `json:"check,omitempty"` // solo con ?solve=true
+}
+
+// LPSolution es la solución de un problema resuelto para verificar la dualidad
+type LPSolution struct {
+	Status    Status             `json:"status"`
+	Optimal   *float64           `json:"optimal,omitempty"`
+	Variables map[string]float64 `json:"variables,omitempty"`
+}
+
+// DualityCheck compara las soluciones del primal y del dual: dualidad fuerte (mismo
+// valor óptimo) y holgura complementaria (variable dual × holgura = 0 en cada par)
+type DualityCheck struct {
+	Primal                 LPSolution         `json:"primal"`
+	Dual                   LPSolution         `json:"dual"`
+	StrongDuality          bool               `json:"strong_duality"`
+	DualityGap             *float64           `json:"duality_gap,omitempty"`
+	ComplementarySlackness bool               `json:"complementary_slackness"`
+	Violations             []DualityViolation `json:"violations,omitempty"`
+	Message                string             `json:"message"`
+}
+
+// DualityViolation es una condición de dualidad que no se cumple. Code es
+// "status_mismatch", "strong_duality" o "complementary_slackness"; Constraint y
+// Variable identifican el par de holgura complementaria.
+type DualityViolation struct {
+	Code       string  `json:"code"`
+	Constraint string  `json:"constraint,omitempty"`
+	Variable   string  `json:"variable,omitempty"`
+	Value      float64 `json:"value"`
+	Message    string  `json:"message"`
+}
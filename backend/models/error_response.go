@@ -0,0 +1,17 @@
+package models
+
+// ErrorResponse es el cuerpo de todas las respuestas de error de la API
+type ErrorResponse struct {
+	Error APIError `json:"error"`
+}
+
+// APIError describe un error con un código estable para los clientes y un mensaje
+// en el idioma pedido. Field es la ruta del campo del modelo que lo causó (por ejemplo
+// "constraints[2][1]"). Cuando hay varios errores de validación, Code es
+// "invalid_model" (o "unsupported_model") y Details["errors"] los lista a todos.
+type APIError struct {
+	Code    string         `json:"code"`
+	Message string         `json:"message"`
+	Field   string         `json:"field,omitempty"`
+	Details map[string]any `json:"details,omitempty"`
+}
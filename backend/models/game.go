@@ -0,0 +1,66 @@
+package models
+
+// Jugadores de un juego de suma cero
+const (
+	PlayerRow    = "row"    // jugador fila (maximiza su ganancia)
+	PlayerColumn = "column" // jugador columna (minimiza lo que paga)
+)
+
+// GameRequest es el cuerpo de /api/game: la matriz de pagos al jugador fila (una fila
+// por cada estrategia suya y una columna por cada estrategia del jugador columna)
+type GameRequest struct {
+	Payoffs [][]float64 `json:"payoffs"`
+
+	RowNames    []string `json:"row_names,omitempty"`    // A1, A2... por defecto
+	ColumnNames []string `json:"column_names,omitempty"` // B1, B2... por defecto
+	Language    string   `json:"language,omitempty"`
+}
+
+// GameCell es una celda de la matriz de pagos: estrategia de cada jugador
+type GameCell struct {
+	Row int `json:"row"`
+	Col int `json:"col"`
+}
+
+// Dominance es una estrategia eliminada por estar dominada por otra del mismo
+// jugador (índices de la matriz original)
+type Dominance struct {
+	Player      string `json:"player"`
+	Removed     int    `json:"removed"`
+	By          int    `json:"by"`
+	Description string `json:"description"`
+}
+
+// GameLP es el programa lineal de un jugador sobre la matriz reducida y desplazada,
+// con la solución del simplex (tablas incluidas)
+type GameLP struct {
+	Model    SimplexRequest  `json:"model"`
+	Solution SimplexResponse `json:"solution"`
+}
+
+// GameResponse es la solución del juego. Si hay punto de silla las estrategias son
+// puras y no se resuelven programas lineales; si no, se eliminan las estrategias
+// dominadas, se suma Shift a los pagos para que sean positivos y se resuelve un
+// programa lineal por jugador. Las estrategias tienen una probabilidad por cada
+// estrategia original (0 en las eliminadas).
+type GameResponse struct {
+	Rows    []string `json:"rows"`
+	Columns []string `json:"columns"`
+
+	Maximin      float64    `json:"maximin"` // máximo de los mínimos de cada fila
+	Minimax      float64    `json:"minimax"` // mínimo de los máximos de cada columna
+	SaddlePoints []GameCell `json:"saddle_points,omitempty"`
+
+	Dominances     []Dominance `json:"dominances,omitempty"`
+	ReducedRows    []int       `json:"reduced_rows,omitempty"`
+	ReducedColumns []int       `json:"reduced_columns,omitempty"`
+	Shift          float64     `json:"shift,omitempty"`
+	RowLP          *GameLP     `json:"row_lp,omitempty"`
+	ColumnLP       *GameLP     `json:"column_lp,omitempty"`
+
+	Value          float64   `json:"value"`
+	RowStrategy    []float64 `json:"row_strategy"`
+	ColumnStrategy []float64 `json:"column_strategy"`
+	Status         Status    `json:"status"`
+	Message        string    `json:"message"`
+}
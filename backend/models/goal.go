@@ -0,0 +1,68 @@
+package models
+
+// Métodos de programación por metas
+const (
+	GoalWeighted      = "weighted"      // minimiza la suma ponderada de las desviaciones
+	GoalLexicographic = "lexicographic" // resuelve los niveles de prioridad en orden
+)
+
+// GoalRequest es el cuerpo de /api/goal: metas sobre las variables de decisión y
+// restricciones duras (le, ge o eq) que no se pueden violar. Cada meta recibe
+// automáticamente las desviaciones d- (por debajo) y d+ (por encima) de su valor
+// objetivo.
+type GoalRequest struct {
+	Goals  []Goal `json:"goals"`
+	Method string `json:"method,omitempty"` // weighted por defecto
+
+	Constraints     [][]float64 `json:"constraints,omitempty"`
+	RHS             []float64   `json:"rhs,omitempty"`
+	ConstraintTypes []string    `json:"constraint_types,omitempty"`
+
+	VariableNames []string `json:"variable_names,omitempty"` // x1, x2... por defecto
+	Language      string   `json:"language,omitempty"`
+}
+
+// Goal es una meta: Coefficients·x comparado con Target. Type indica qué desviación
+// se penaliza: "ge" (llegar al menos a Target, penaliza d-), "le" (no superarlo,
+// penaliza d+) o "eq" (las dos). Weight pondera la desviación (1 si se omite; un 0
+// explícito deja la meta sin penalizar) y Priority es el nivel en el método
+// lexicográfico (1 es el más importante).
+type Goal struct {
+	Name         string    `json:"name,omitempty"` // G1, G2... por defecto
+	Coefficients []float64 `json:"coefficients"`
+	Target       float64   `json:"target"`
+	Type         string    `json:"type"`
+	Weight       *float64  `json:"weight,omitempty"`
+	Priority     int       `json:"priority,omitempty"`
+}
+
+// GoalStage es un programa lineal resuelto con el simplex: uno solo con el método
+// ponderado o uno por nivel de prioridad en el lexicográfico, donde cada nivel
+// agrega como restricción la desviación lograda por los anteriores
+type GoalStage struct {
+	Priority  int             `json:"priority,omitempty"`
+	Goals     []string        `json:"goals"`
+	Deviation float64         `json:"deviation"` // desviación ponderada mínima del nivel
+	Model     SimplexRequest  `json:"model"`
+	Solution  SimplexResponse `json:"solution"`
+}
+
+// GoalAchievement es el resultado de una meta en la solución final
+type GoalAchievement struct {
+	Name     string  `json:"name"`
+	Target   float64 `json:"target"`
+	Value    float64 `json:"value"` // Coefficients·x
+	Under    float64 `json:"under"` // d-
+	Over     float64 `json:"over"`  // d+
+	Achieved bool    `json:"achieved"`
+}
+
+// GoalResponse es la solución del modelo de programación por metas
+type GoalResponse struct {
+	Method    string             `json:"method"`
+	Stages    []GoalStage        `json:"stages"`
+	Variables map[string]float64 `json:"variables,omitempty"`
+	Goals     []GoalAchievement  `json:"goals,omitempty"`
+	Status    Status             `json:"status"`
+	Message   string             `json:"message"`
+}
@@ -0,0 +1,53 @@
+package models
+
+// GradeRequest es el cuerpo de /api/simplex/grade: el modelo (mismos campos que
+// /api/simplex) y las tablas del alumno, con la forma de tableaux_history. El pivote
+// de cada iteración se deduce del cambio de base entre las dos tablas o, si no se
+// puede, de pivot_row/pivot_col (o entering/leaving) de la tabla anterior. Tolerance
+// es la diferencia admitida en cada celda (0.01 por defecto).
+type GradeRequest struct {
+	SimplexRequest
+	Tableaux  []TableauStep `json:"tableaux"`
+	Tolerance float64       `json:"tolerance,omitempty"`
+}
+
+// CellError es una celda mal calculada: valor esperado según el pivote aplicado a la
+// tabla anterior del alumno y valor recibido
+type CellError struct {
+	Row      int     `json:"row"`
+	Col      int     `json:"col"`
+	Header   string  `json:"header"`
+	Expected float64 `json:"expected"`
+	Got      float64 `json:"got"`
+}
+
+// StepGrade es la corrección de una tabla. La tabla 0 se compara con la tabla inicial
+// (no tiene pivote); las siguientes verifican el pivote usado para llegar a ellas y
+// las operaciones de fila.
+type StepGrade struct {
+	Step            int          `json:"step"`
+	Correct         bool         `json:"correct"`
+	FirstError      bool         `json:"first_error,omitempty"`
+	Pivot           *PivotChoice `json:"pivot,omitempty"`
+	PivotValid      bool         `json:"pivot_valid"`
+	MatchesRule     bool         `json:"matches_rule"`
+	ArithmeticValid bool         `json:"arithmetic_valid"`
+	Cells           []CellError  `json:"cells,omitempty"`
+	Message         string       `json:"message"`
+}
+
+// GradeResponse es el informe de corrección: cada tabla, la primera con error, si la
+// tabla final resuelve el problema y la nota (fracción de tablas correctas, contando
+// la verificación final como una más)
+type GradeResponse struct {
+	Steps             []StepGrade `json:"steps"`
+	FirstError        *int        `json:"first_error,omitempty"`
+	FinalCorrect      bool        `json:"final_correct"`
+	FinalStatus       Status      `json:"final_status,omitempty"`
+	ExpectedStatus    Status      `json:"expected_status"`
+	FinalObjective    *float64    `json:"final_objective,omitempty"`
+	ExpectedObjective *float64    `json:"expected_objective,omitempty"`
+	Score             float64     `json:"score"`
+	Passed            bool        `json:"passed"`
+	Message           string      `json:"message"`
+}
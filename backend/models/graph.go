@@ -0,0 +1,65 @@
+package models
+
+// FeasibleRegion es la región factible de un modelo de 2 o 3 variables para el método
+// gráfico. Los vértices salen de intersecar las restricciones de a pares (o de a
+// ternas); si la región no es acotada se recorta con la vista (ViewMin, ViewMax) y los
+// vértices del recorte se marcan como Clipped. En 2 variables Vertices está en orden
+// (es el polígono) y en 3 variables Faces da las caras del poliedro.
+type FeasibleRegion struct {
+	Dimension   int            `json:"dimension"`
+	Variables   []string       `json:"variables"`
+	Objective   []float64      `json:"objective"`
+	Constraints []RegionPlane  `json:"constraints"`
+	Vertices    []RegionVertex `json:"vertices"`
+	Faces       []RegionFace   `json:"faces,omitempty"`
+	Bounded     bool           `json:"bounded"`
+	ViewMin     []float64      `json:"view_min"`
+	ViewMax     []float64      `json:"view_max"`
+
+	// Solución del modelo: estado, valor óptimo y vértices óptimos (más de uno si el
+	// óptimo es una arista o una cara). IsoLines son los valores de Z de las rectas
+	// de nivel que se dibujan en 2 variables.
+	Status          Status    `json:"status"`
+	Optimal         *float64  `json:"optimal,omitempty"`
+	OptimalVertices []int     `json:"optimal_vertices,omitempty"`
+	IsoLines        []float64 `json:"iso_lines,omitempty"`
+
+	// Recorrido del Simplex: el punto de cada tabla del historial y el vértice que
+	// visita (vacío si el solver no puede resolver el modelo con tablas)
+	Method string       `json:"method,omitempty"`
+	Path   []RegionStep `json:"path,omitempty"`
+}
+
+// RegionPlane es una restricción del modelo (coeficientes · x tipo rhs). Incluye las
+// cotas (lb_x, ub_x) y el signo de las variables ("x1 >= 0").
+type RegionPlane struct {
+	Name         string    `json:"name"`
+	Coefficients []float64 `json:"coefficients"`
+	Type         string    `json:"type"`
+	RHS          float64   `json:"rhs"`
+}
+
+// RegionVertex es un vértice de la región: coordenadas, valor de Z y restricciones
+// activas. Clipped indica que el vértice es del recorte de la vista y no del modelo.
+type RegionVertex struct {
+	Point     []float64 `json:"point"`
+	Objective float64   `json:"objective"`
+	Active    []string  `json:"active,omitempty"`
+	Clipped   bool      `json:"clipped,omitempty"`
+}
+
+// RegionFace es una cara del poliedro en 3 variables: la restricción que la define y
+// los índices de sus vértices en orden
+type RegionFace struct {
+	Constraint string `json:"constraint,omitempty"` // vacío en las caras del recorte
+	Vertices   []int  `json:"vertices"`
+	Clipped    bool   `json:"clipped,omitempty"`
+}
+
+// RegionStep es el punto de una tabla del historial (Step es su índice). Vertex es el
+// índice del vértice que visita o null si el punto no es factible (simplex dual).
+type RegionStep struct {
+	Step   int       `json:"step"`
+	Point  []float64 `json:"point"`
+	Vertex *int      `json:"vertex"`
+}
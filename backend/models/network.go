@@ -0,0 +1,98 @@
+package models
+
+// Problemas de redes que se resuelven como flujo de costo mínimo
+const (
+	NetworkMinCost      = "min_cost"      // flujo de costo mínimo con las ofertas de los nodos
+	NetworkMaxFlow      = "max_flow"      // flujo máximo de Source a Sink
+	NetworkShortestPath = "shortest_path" // camino más corto de Source a Sink
+)
+
+// Tipos de arco de la red resuelta
+const (
+	ArcArtificial = "artificial" // arco a la raíz artificial del árbol inicial
+	ArcReturn     = "return"     // arco de Sink a Source del flujo máximo
+)
+
+// NetworkRequest es el cuerpo de /api/network. Con problem "max_flow" se ignoran las
+// ofertas y los costos, y con "shortest_path" las ofertas y las capacidades: en los
+// dos casos hay que indicar Source y Sink.
+type NetworkRequest struct {
+	Nodes   []NetworkNode `json:"nodes"`
+	Arcs    []NetworkArc  `json:"arcs"`
+	Problem string        `json:"problem,omitempty"` // min_cost por defecto
+
+	Source   string `json:"source,omitempty"`
+	Sink     string `json:"sink,omitempty"`
+	Language string `json:"language,omitempty"`
+}
+
+// NetworkNode es un nodo de la red: Supply positivo es oferta y negativo demanda
+type NetworkNode struct {
+	Name   string  `json:"name"`
+	Supply float64 `json:"supply,omitempty"`
+}
+
+// NetworkArc es un arco dirigido con costo unitario y cotas de flujo (sin Upper la
+// capacidad es ilimitada)
+type NetworkArc struct {
+	From  string   `json:"from"`
+	To    string   `json:"to"`
+	Cost  float64  `json:"cost,omitempty"`
+	Lower float64  `json:"lower,omitempty"`
+	Upper *float64 `json:"upper,omitempty"`
+}
+
+// NetworkArcFlow es un arco de la red resuelta con el costo usado por el solver, su
+// flujo óptimo y su costo reducido (null en los arcos del árbol). Kind distingue los
+// arcos agregados por el solver.
+type NetworkArcFlow struct {
+	NetworkArc
+	Kind        string   `json:"kind,omitempty"`
+	Flow        float64  `json:"flow"`
+	ReducedCost *float64 `json:"reduced_cost"`
+}
+
+// NetworkCycleArc es un arco del ciclo de una iteración: Forward indica si el flujo
+// aumenta (el arco va en el sentido del ciclo) o disminuye
+type NetworkCycleArc struct {
+	Arc     int  `json:"arc"`
+	Forward bool `json:"forward"`
+}
+
+// NetworkIteration es una iteración del simplex de redes: arcos del árbol generador
+// (índices de Arcs), flujo de cada arco, potenciales de los nodos (la raíz artificial
+// vale 0) y costos reducidos de los arcos fuera del árbol. Si no es la última incluye
+// el arco que entra, el ciclo que forma con el árbol (empieza en el vértice común y
+// sigue el sentido del arco que entra), el flujo que se mueve y el arco que sale
+// (puede ser el mismo que entra si solo pasa de una cota a la otra).
+type NetworkIteration struct {
+	Tree         []int      `json:"tree"`
+	Flows        []float64  `json:"flows"`
+	Potentials   []float64  `json:"potentials"`
+	ReducedCosts []*float64 `json:"reduced_costs"`
+	Cost         float64    `json:"cost"`
+
+	Entering *int              `json:"entering,omitempty"`
+	Cycle    []NetworkCycleArc `json:"cycle,omitempty"`
+	Theta    float64           `json:"theta,omitempty"`
+	Leaving  *int              `json:"leaving,omitempty"`
+}
+
+// NetworkResponse es la solución del problema de redes. Nodes termina en la raíz
+// artificial y Arcs incluye, después de los arcos pedidos, los que agregó el solver.
+// Potentials son los duales de la conservación de flujo (costo reducido
+// c_ij - p_i + p_j), con el primer nodo en 0.
+type NetworkResponse struct {
+	Problem    string             `json:"problem"`
+	Nodes      []string           `json:"nodes"`
+	Arcs       []NetworkArcFlow   `json:"arcs"`
+	Iterations []NetworkIteration `json:"iterations"`
+	Potentials []float64          `json:"potentials"`
+	TotalCost  float64            `json:"total_cost"`
+
+	FlowValue float64  `json:"flow_value,omitempty"` // flujo máximo
+	Path      []string `json:"path,omitempty"`       // camino más corto
+
+	Status  Status `json:"status"`
+	Message string `json:"message"`
+}
@@ -0,0 +1,34 @@
+package models
+
+// Sensitivity es el análisis de sensibilidad de la tabla óptima: costos reducidos y
+// rangos de los coeficientes del objetivo por variable, y holguras, precios sombra y
+// rangos del lado derecho por restricción (incluidas las filas de las cotas). Los
+// rangos son los valores entre los que la base óptima no cambia; null es infinito.
+type Sensitivity struct {
+	Variables   []VariableSensitivity   `json:"variables"`
+	Constraints []ConstraintSensitivity `json:"constraints"`
+}
+
+// VariableSensitivity es la sensibilidad de una variable de decisión. ReducedCost es
+// lo que cambia la función objetivo por cada unidad que se fuerce la variable (0 si
+// es básica).
+type VariableSensitivity struct {
+	Name        string   `json:"name"`
+	Value       float64  `json:"value"`
+	ReducedCost float64  `json:"reduced_cost"`
+	Coefficient float64  `json:"coefficient"`
+	Lower       *float64 `json:"lower"`
+	Upper       *float64 `json:"upper"`
+}
+
+// ConstraintSensitivity es la sensibilidad de una restricción. ShadowPrice es lo que
+// cambia la función objetivo por cada unidad que aumenta el lado derecho.
+type ConstraintSensitivity struct {
+	Name        string   `json:"name"`
+	Type        string   `json:"type"`
+	Slack       float64  `json:"slack"`
+	ShadowPrice float64  `json:"shadow_price"`
+	RHS         float64  `json:"rhs"`
+	Lower       *float64 `json:"lower"`
+	Upper       *float64 `json:"upper"`
+}
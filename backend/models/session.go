@@ -0,0 +1,36 @@
+package models
+
+// PivotChoice es un pivote: fila y columna de la tabla (los mismos índices que
+// pivot_row y pivot_col del historial) y las variables que entran y salen
+type PivotChoice struct {
+	Row      int    `json:"row"`
+	Col      int    `json:"col"`
+	Entering string `json:"entering,omitempty"`
+	Leaving  string `json:"leaving,omitempty"`
+}
+
+// PivotFeedback evalúa el pivote elegido por el usuario: si es válido para el método
+// (solo los válidos se aplican) y si coincide con la regla que usaría el solver
+// (empates incluidos). Suggested es el pivote que habría elegido el solver.
+type PivotFeedback struct {
+	Valid       bool         `json:"valid"`
+	MatchesRule bool         `json:"matches_rule"`
+	Suggested   *PivotChoice `json:"suggested,omitempty"`
+	Message     string       `json:"message"`
+}
+
+// SessionResponse es el estado de una sesión del modo interactivo: la tabla actual,
+// si ya es final (con su estado y solución) y la evaluación del último pivote
+type SessionResponse struct {
+	ID        string             `json:"id"`
+	Method    string             `json:"method"`
+	Iteration int                `json:"iteration"`
+	Tableau   TableauStep        `json:"tableau"`
+	Finished  bool               `json:"finished"`
+	Status    Status             `json:"status,omitempty"`
+	Optimal   *float64           `json:"optimal,omitempty"`
+	Variables map[string]float64 `json:"variables,omitempty"`
+	CanUndo   bool               `json:"can_undo"`
+	Message   string             `json:"message"`
+	Feedback  *PivotFeedback     `json:"feedback,omitempty"`
+}
@@ -7,4 +7,27 @@ type SimplexRequest struct {
 	RHS             []float64   `json:"rhs"`         // términos independientes
 	Type            string      `json:"type"`        // "max" o "min"
 	ConstraintTypes []string    `json:"constraint_types"`
+
+	// Campos opcionales (los completan los parsers de modelos en texto)
+	VariableNames   []string `json:"variable_names,omitempty"`   // nombre de cada columna (x1, x2... por defecto)
+	ConstraintNames []string `json:"constraint_names,omitempty"` // nombre de cada restricción
+	Bounds          []Bound  `json:"bounds,omitempty"`           // una cota por variable
+	Integer         []bool   `json:"integer,omitempty"`          // variables declaradas enteras
+
+	// Explicación paso a paso de cada iteración (explain) e idioma de los mensajes y
+	// explicaciones: "es" (por defecto) o "en". Si falta, la API usa Accept-Language.
+	Explain  bool   `json:"explain,omitempty"`
+	Language string `json:"language,omitempty"`
+
+	// Enumerar las soluciones básicas óptimas alternativas (hasta MaxAlternatives, 10 por defecto)
+	Alternatives    bool `json:"alternatives,omitempty"`
+	MaxAlternatives int  `json:"max_alternatives,omitempty"`
+}
+
+// Bound acota una variable de decisión: Lower <= x <= Upper.
+// Lower nil equivale a 0 (no negatividad) y Upper nil a +infinito.
+type Bound struct {
+	Lower *float64 `json:"lower,omitempty"`
+	Upper *float64 `json:"upper,omitempty"`
+	Free  bool     `json:"free,omitempty"` // sin cota inferior (-infinito)
 }
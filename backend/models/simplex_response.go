@@ -7,11 +7,137 @@ type SimplexTableau [][]float64
 type TableauStep struct {
 	Headers []string       `json:"headers"`
 	Matrix  SimplexTableau `json:"matrix"`
+
+	// Matriz sin truncar, para las exportaciones que muestran fracciones exactas
+	Exact SimplexTableau `json:"-"`
+
+	// Estado de la tabla: variable básica de cada fila de la matriz (la fila 0 es "Z")
+	// y valor de la función objetivo
+	Basis     []string `json:"basis"`
+	Objective float64  `json:"objective"`
+
+	// Decisión de pivoteo tomada sobre esta tabla (vacía en la tabla final).
+	// PivotRow y PivotCol son índices de Matrix.
+	Entering   string  `json:"entering,omitempty"`
+	Leaving    string  `json:"leaving,omitempty"`
+	PivotRow   int     `json:"pivot_row,omitempty"`
+	PivotCol   int     `json:"pivot_col,omitempty"`
+	PivotValue float64 `json:"pivot_value,omitempty"`
+
+	// Prueba del cociente: en el Simplex primal un cociente por fila de la matriz y
+	// en el dual uno por columna; null donde no corresponde (divisor no válido)
+	Ratios       []*float64 `json:"ratios,omitempty"`
+	ColumnRatios []*float64 `json:"column_ratios,omitempty"`
+
+	// Degeneración: alguna variable básica vale 0 en esta tabla y/o el pivote elegido
+	// tiene cociente 0 (la función objetivo no cambia en esta iteración)
+	DegenerateBasis bool `json:"degenerate_basis,omitempty"`
+	DegeneratePivot bool `json:"degenerate_pivot,omitempty"`
+
+	// Explicación en lenguaje natural de la decisión tomada (solo con explain: true)
+	Explanation string `json:"explanation,omitempty"`
 }
 
+// SimplexResponse es la respuesta v1 de /api/simplex. Status es un texto ("optimal",
+// "optimal (degenerate: multiple solutions)", "error: ..."); el estado tipado y las
+// banderas se exponen en la respuesta v2 (SimplexResponseV2).
 type SimplexResponse struct {
 	Variables       map[string]float64 `json:"variables"`
 	Optimal         float64            `json:"optimal"`
 	Status          string             `json:"status"`
+	Method          string             `json:"method,omitempty"`  // "primal" o "dual": camino usado por el solver
+	Message         string             `json:"message,omitempty"` // descripción del estado en el idioma pedido
 	TableauxHistory []TableauStep      `json:"tableaux_history,omitempty"`
+
+	// Cantidad de iteraciones con pivote degenerado
+	DegenerateIterations int `json:"degenerate_iterations,omitempty"`
+
+	// Óptimos alternativos: variables no básicas con costo reducido 0 en la tabla
+	// óptima y, si se pidieron (alternatives: true), las soluciones básicas óptimas
+	// y la cara óptima que generan
+	ZeroReducedCost []string        `json:"zero_reduced_cost,omitempty"`
+	Alternatives    []BasicSolution `json:"alternative_solutions,omitempty"`
+	OptimalFace     *OptimalFace    `json:"optimal_face,omitempty"`
+
+	// Análisis de sensibilidad de la tabla óptima
+	Sensitivity *Sensitivity `json:"sensitivity,omitempty"`
+
+	// Estado tipado, banderas de la solución y error que llevó a un Status "error: ..."
+	// (para poder traducirlo). Solo se serializan en la respuesta v2.
+	State             Status `json:"-"`
+	Degenerate        bool   `json:"-"` // alguna variable básica vale 0 en la tabla final
+	AlternativeOptima bool   `json:"-"` // hay otras soluciones con el mismo valor óptimo
+	ConstantObjective bool   `json:"-"` // la función objetivo es constante: todo punto factible es óptimo
+	Err               error  `json:"-"`
+
+	// Tabla óptima sin truncar y base (columna básica de cada fila de restricción),
+	// para los análisis que siguen pivoteando sobre ella
+	FinalTableau SimplexTableau `json:"-"`
+	FinalBasis   []int          `json:"-"`
+}
+
+// BasicSolution es una solución básica: el valor de cada variable de decisión y las
+// variables básicas que la definen
+type BasicSolution struct {
+	Variables map[string]float64 `json:"variables"`
+	Basis     []string           `json:"basis"`
+}
+
+// OptimalFace describe el conjunto de soluciones óptimas como combinaciones convexas
+// de los vértices óptimos más combinaciones no negativas de las direcciones (rayos)
+// a lo largo de las cuales el óptimo no cambia. Complete es false si se alcanzó el
+// límite de soluciones antes de recorrer toda la cara.
+type OptimalFace struct {
+	Vertices    []map[string]float64 `json:"vertices"`
+	Rays        []map[string]float64 `json:"rays,omitempty"`
+	Complete    bool                 `json:"complete"`
+	Description string               `json:"description"`
+}
+
+// SimplexResponseV2 es la respuesta de /api/v2/simplex: estado tipado, banderas
+// separadas y un objeto de error en lugar de textos que el cliente tenga que interpretar
+type SimplexResponseV2 struct {
+	Version              string             `json:"version"`
+	Status               Status             `json:"status"`
+	Degenerate           bool               `json:"degenerate"`
+	AlternativeOptima    bool               `json:"alternative_optima"`
+	DegenerateIterations int                `json:"degenerate_iterations"`
+	Optimal              *float64           `json:"optimal,omitempty"` // solo con status optimal
+	Variables            map[string]float64 `json:"variables,omitempty"`
+	Method               string             `json:"method,omitempty"`
+	Message              string             `json:"message,omitempty"`
+	Error                *APIError          `json:"error,omitempty"`
+	TableauxHistory      []TableauStep      `json:"tableaux_history,omitempty"`
+	ZeroReducedCost      []string           `json:"zero_reduced_cost,omitempty"`
+	Alternatives         []BasicSolution    `json:"alternative_solutions,omitempty"`
+	OptimalFace          *OptimalFace       `json:"optimal_face,omitempty"`
+	Sensitivity          *Sensitivity       `json:"sensitivity,omitempty"`
+}
+
+// ResponseVersion2 es la versión informada en SimplexResponseV2
+const ResponseVersion2 = "2"
+
+// V2 convierte la respuesta al formato v2. El objeto de error lo completa quien
+// responde, porque el mensaje depende del idioma pedido.
+func (r SimplexResponse) V2() SimplexResponseV2 {
+	v2 := SimplexResponseV2{
+		Version:              ResponseVersion2,
+		Status:               r.State,
+		Degenerate:           r.Degenerate,
+		AlternativeOptima:    r.AlternativeOptima,
+		DegenerateIterations: r.DegenerateIterations,
+		Method:               r.Method,
+		Message:              r.Message,
+		TableauxHistory:      r.TableauxHistory,
+	}
+	if r.State == StatusOptimal {
+		optimal := r.Optimal
+		v2.Optimal = &optimal
+		v2.Variables = r.Variables
+		v2.ZeroReducedCost = r.ZeroReducedCost
+		v2.Alternatives = r.Alternatives
+		v2.OptimalFace = r.OptimalFace
+		v2.Sensitivity = r.Sensitivity
+	}
+	return v2
 }
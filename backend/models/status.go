@@ -0,0 +1,13 @@
+package models
+
+// Status es el estado tipado de la resolución
+type Status string
+
+const (
+	StatusOptimal        Status = "optimal"
+	StatusInfeasible     Status = "infeasible"
+	StatusUnbounded      Status = "unbounded"
+	StatusIterationLimit Status = "iteration_limit"
+	StatusTimeLimit      Status = "time_limit" // reservado: el solver todavía no tiene límite de tiempo
+	StatusError          Status = "error"
+)
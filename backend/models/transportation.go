@@ -0,0 +1,80 @@
+package models
+
+// Métodos para la solución inicial del problema de transporte
+const (
+	TransportNorthwest = "northwest"  // esquina noroeste
+	TransportLeastCost = "least_cost" // costo mínimo
+	TransportVogel     = "vogel"      // aproximación de Vogel
+)
+
+// TransportationRequest es el cuerpo de /api/transportation: oferta de cada origen,
+// demanda de cada destino y costo unitario de cada ruta (una fila por origen). Si la
+// oferta total no coincide con la demanda se agrega un origen o destino ficticio con
+// costo 0. Method elige la solución inicial (northwest por defecto).
+type TransportationRequest struct {
+	Supply []float64   `json:"supply"`
+	Demand []float64   `json:"demand"`
+	Costs  [][]float64 `json:"costs"`
+	Method string      `json:"method,omitempty"`
+
+	SourceNames      []string `json:"source_names,omitempty"`      // O1, O2... por defecto
+	DestinationNames []string `json:"destination_names,omitempty"` // D1, D2... por defecto
+	Language         string   `json:"language,omitempty"`
+}
+
+// TransportCell es una ruta de la tabla: fila del origen y columna del destino
+type TransportCell struct {
+	Row int `json:"row"`
+	Col int `json:"col"`
+}
+
+// InitialAllocation es una asignación del método inicial: la ruta elegida y la
+// cantidad enviada. En Vogel incluye las penalizaciones de las filas y columnas
+// todavía abiertas (null en las tachadas).
+type InitialAllocation struct {
+	TransportCell
+	Amount          float64    `json:"amount"`
+	RowPenalties    []*float64 `json:"row_penalties,omitempty"`
+	ColumnPenalties []*float64 `json:"column_penalties,omitempty"`
+}
+
+// TransportationStep es una tabla del método MODI: asignación actual, potenciales de
+// filas (U) y columnas (V), costos reducidos de las rutas no básicas (null en las
+// básicas) y la decisión tomada: ruta que entra, ciclo de stepping-stone (empieza en
+// la que entra, con signos + y - alternados), cantidad que se mueve y ruta que sale.
+// En la tabla final no hay decisión.
+type TransportationStep struct {
+	Allocation   [][]float64     `json:"allocation"`
+	Basis        []TransportCell `json:"basis"`
+	Cost         float64         `json:"cost"`
+	U            []float64       `json:"u"`
+	V            []float64       `json:"v"`
+	ReducedCosts [][]*float64    `json:"reduced_costs"`
+
+	Entering *TransportCell  `json:"entering,omitempty"`
+	Cycle    []TransportCell `json:"cycle,omitempty"`
+	Theta    float64         `json:"theta,omitempty"`
+	Leaving  *TransportCell  `json:"leaving,omitempty"`
+}
+
+// TransportationResponse es la solución del problema de transporte balanceado
+type TransportationResponse struct {
+	Sources      []string    `json:"sources"`
+	Destinations []string    `json:"destinations"`
+	Supply       []float64   `json:"supply"`
+	Demand       []float64   `json:"demand"`
+	Costs        [][]float64 `json:"costs"`
+
+	// Origen o destino ficticio agregado para balancear ("source" o "destination")
+	Dummy  string `json:"dummy,omitempty"`
+	Method string `json:"method"`
+
+	Initial           []InitialAllocation  `json:"initial"`
+	Steps             []TransportationStep `json:"steps"`
+	Allocation        [][]float64          `json:"allocation"`
+	TotalCost         float64              `json:"total_cost"`
+	Status            Status               `json:"status"`
+	Degenerate        bool                 `json:"degenerate"`         // alguna ruta básica con envío 0
+	AlternativeOptima bool                 `json:"alternative_optima"` // alguna ruta no básica con costo reducido 0
+	Message           string               `json:"message"`
+}
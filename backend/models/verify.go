@@ -0,0 +1,47 @@
+package models
+
+// VerifyRequest es el cuerpo de /api/simplex/verify: el modelo (mismos campos que
+// /api/simplex) y la solución a verificar. Solution tiene el valor de cada variable
+// por nombre (las que faltan valen 0) y Duals, opcional, el valor de la variable dual
+// de cada restricción por nombre de restricción (c1, c2... o constraint_names).
+// Tolerance es la diferencia admitida en cada restricción y en el valor óptimo (0.01
+// por defecto, para aceptar soluciones calculadas con dos decimales).
+type VerifyRequest struct {
+	SimplexRequest
+	Solution  map[string]float64 `json:"solution"`
+	Duals     map[string]float64 `json:"duals,omitempty"`
+	Tolerance float64            `json:"tolerance,omitempty"`
+}
+
+// ConstraintResidual es el estado de una restricción en la solución verificada.
+// Residual = LHS - RHS; también se listan las cotas y el signo de cada variable.
+type ConstraintResidual struct {
+	Name      string  `json:"name"`
+	Type      string  `json:"type"`
+	LHS       float64 `json:"lhs"`
+	RHS       float64 `json:"rhs"`
+	Residual  float64 `json:"residual"`
+	Satisfied bool    `json:"satisfied"`
+}
+
+// VerifyResponse es el resultado de la verificación. La optimalidad se decide con el
+// valor óptimo del modelo; ReducedCosts, DualFeasible y DualityGap se calculan con
+// los duales indicados o, si no se enviaron, con los duales óptimos (DualsSource
+// "claimed" o "computed").
+type VerifyResponse struct {
+	Objective    float64              `json:"objective"`
+	Feasible     bool                 `json:"feasible"`
+	Residuals    []ConstraintResidual `json:"residuals"`
+	Optimal      bool                 `json:"optimal"`
+	State        Status               `json:"status"` // estado del modelo resuelto
+	OptimalValue *float64             `json:"optimal_value,omitempty"`
+
+	Duals         map[string]float64 `json:"duals,omitempty"`
+	DualsSource   string             `json:"duals_source,omitempty"`
+	ReducedCosts  map[string]float64 `json:"reduced_costs,omitempty"`
+	DualFeasible  bool               `json:"dual_feasible"`
+	DualObjective *float64           `json:"dual_objective,omitempty"`
+	DualityGap    *float64           `json:"duality_gap,omitempty"`
+
+	Message string `json:"message"`
+}
@@ -0,0 +1,45 @@
+package models
+
+// VertexEnumeration es el resultado de enumerar las soluciones básicas del modelo
+// probando todas las bases de la forma estándar (variables y holguras). Cada vértice
+// aparece una vez aunque lo generen varias bases (vértice degenerado).
+type VertexEnumeration struct {
+	Variables []string `json:"variables"`
+	Vertices  []Vertex `json:"vertices"`
+
+	// Bases probadas y cuántas fueron singulares o no factibles (alguna variable básica
+	// negativa)
+	Bases           int `json:"bases"`
+	SingularBases   int `json:"singular_bases"`
+	InfeasibleBases int `json:"infeasible_bases"`
+
+	// Mejor valor de Z entre los vértices y los vértices que lo alcanzan. Si la región
+	// no es acotada puede no ser el óptimo del modelo.
+	BestObjective *float64 `json:"best_objective,omitempty"`
+	BestVertices  []int    `json:"best_vertices,omitempty"`
+
+	// Recorrido del Simplex: el vértice de cada tabla del historial del solver
+	Method  string       `json:"method,omitempty"`
+	Path    []VertexStep `json:"path,omitempty"`
+	Message string       `json:"message"`
+}
+
+// Vertex es una solución básica factible: valores de las variables y de la holgura de
+// cada restricción (por nombre), valor de Z, restricciones activas (holgura 0 y
+// variables en 0, "x1 >= 0") y las bases que la generan
+type Vertex struct {
+	Variables  map[string]float64 `json:"variables"`
+	Slacks     map[string]float64 `json:"slacks"`
+	Objective  float64            `json:"objective"`
+	Active     []string           `json:"active"`
+	Bases      [][]string         `json:"bases"`
+	Degenerate bool               `json:"degenerate"`
+}
+
+// VertexStep es la base de una tabla del historial (Step es su índice) y el vértice
+// que genera, o null si la base no es factible (simplex dual)
+type VertexStep struct {
+	Step   int      `json:"step"`
+	Basis  []string `json:"basis"`
+	Vertex *int     `json:"vertex"`
+}
@@ -0,0 +1,270 @@
+package report
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"maps"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"proyecto/simplex/models"
+)
+
+// LaTeXOptions configura la exportación a LaTeX
+type LaTeXOptions struct {
+	Fractions bool // mostrar los valores como fracciones (p. ej. 1/3 en lugar de 0.33)
+}
+
+// WriteLaTeX escribe un documento LaTeX con el enunciado del modelo, cada tabla
+// del historial como tabular (elemento pivote recuadrado, columna entrante y
+// fila saliente resaltadas) y la solución final.
+func WriteLaTeX(w io.Writer, req models.SimplexRequest, result models.SimplexResponse, opts LaTeXOptions) error {
+	bw := bufio.NewWriter(w)
+
+	bw.WriteString(`\documentclass{article}
+\usepackage[utf8]{inputenc}
+\usepackage{amsmath}
+\usepackage[table]{xcolor}
+\definecolor{entering}{RGB}{220,235,255}
+\definecolor{leaving}{RGB}{255,228,205}
+
+\begin{document}
+
+\section*{Modelo}
+`)
+	writeLaTeXModel(bw, req)
+
+	bw.WriteString("\n\\section*{Tablas del método Simplex}\n")
+	for i, step := range result.TableauxHistory {
+		pivotRow, pivotCol := stepPivot(step)
+		fmt.Fprintf(bw, "\n\\subsection*{Iteración %d}\n", i)
+		writeLaTeXTableau(bw, step, pivotRow, pivotCol, opts)
+		if pivotRow > 0 {
+			fmt.Fprintf(bw, "\nEntra $%s$, sale $%s$. Pivote: $%s$.\n",
+				latexSymbol(step.Entering), latexSymbol(step.Leaving), latexNumber(cellValue(step, pivotRow, pivotCol, opts), opts))
+		}
+	}
+
+	bw.WriteString("\n\\section*{Solución}\n")
+	fmt.Fprintf(bw, "Estado: \\texttt{%s}\n", latexEscape(result.Status))
+	if len(result.Variables) > 0 {
+		z, values := result.Optimal, result.Variables
+		if opts.Fractions {
+			z, values = exactSolution(req, result)
+		}
+		bw.WriteString("\\begin{align*}\n")
+		fmt.Fprintf(bw, "  Z^* &= %s", latexNumber(z, opts))
+		for _, name := range variableOrder(req, result) {
+			fmt.Fprintf(bw, " \\\\\n  %s &= %s", latexSymbol(name), latexNumber(values[name], opts))
+		}
+		bw.WriteString("\n\\end{align*}\n")
+	}
+
+	bw.WriteString("\n\\end{document}\n")
+	return bw.Flush()
+}
+
+// writeLaTeXModel escribe la función objetivo, las restricciones y la no negatividad
+func writeLaTeXModel(bw *bufio.Writer, req models.SimplexRequest) {
+	names := modelVariableNames(req)
+
+	sense := `\max`
+	if req.Type == "min" {
+		sense = `\min`
+	}
+	bw.WriteString("\\begin{align*}\n")
+	fmt.Fprintf(bw, "  %s\\; Z &= %s", sense, latexLinear(req.Objective, names))
+
+	ops := map[string]string{"le": `\le`, "ge": `\ge`, "eq": "="}
+	for i, row := range req.Constraints {
+		op := ops["le"]
+		if i < len(req.ConstraintTypes) {
+			op = ops[req.ConstraintTypes[i]]
+		}
+		label := ""
+		if i == 0 {
+			label = `\text{s.a.}\quad `
+		}
+		rhs := 0.0
+		if i < len(req.RHS) {
+			rhs = req.RHS[i]
+		}
+		fmt.Fprintf(bw, " \\\\\n  %s%s &%s %s", label, latexLinear(row, names), op, latexNumber(rhs, LaTeXOptions{}))
+	}
+
+	symbols := make([]string, len(names))
+	for j, n := range names {
+		symbols[j] = latexSymbol(n)
+	}
+	fmt.Fprintf(bw, " \\\\\n  %s &\\ge 0\n\\end{align*}\n", strings.Join(symbols, ", "))
+}
+
+// writeLaTeXTableau escribe una tabla como tabular con la variable básica de cada
+// fila en la primera columna; pivotRow/pivotCol valen -1 si no hay pivote
+func writeLaTeXTableau(bw *bufio.Writer, step models.TableauStep, pivotRow, pivotCol int, opts LaTeXOptions) {
+	hasBasis := len(step.Basis) == len(step.Matrix)
+	spec := strings.Repeat("r", len(step.Headers))
+	if hasBasis {
+		spec = "l|" + spec
+	}
+	fmt.Fprintf(bw, "\\begin{center}\n\\begin{tabular}{%s}\n", spec)
+
+	var headers []string
+	if hasBasis {
+		headers = append(headers, `\text{Base}`)
+	}
+	for j, h := range step.Headers {
+		header := "$" + latexSymbol(h) + "$"
+		if j == pivotCol {
+			header = `\cellcolor{entering}` + header
+		}
+		headers = append(headers, header)
+	}
+	bw.WriteString(strings.Join(headers, " & ") + ` \\ \hline` + "\n")
+
+	for i, row := range step.Matrix {
+		var cells []string
+		if hasBasis {
+			cells = append(cells, "$"+latexSymbol(step.Basis[i])+"$")
+		}
+		for j := range row {
+			value := latexNumber(cellValue(step, i, j, opts), opts)
+			if i == pivotRow && j == pivotCol {
+				value = `\boxed{` + value + `}`
+			}
+			cell := "$" + value + "$"
+			if j == pivotCol && i != pivotRow {
+				cell = `\cellcolor{entering}` + cell
+			}
+			cells = append(cells, cell)
+		}
+		line := strings.Join(cells, " & ") + ` \\`
+		if i == pivotRow {
+			line = `\rowcolor{leaving}` + line
+		}
+		if i == 0 {
+			line += ` \hline`
+		}
+		bw.WriteString(line + "\n")
+	}
+
+	bw.WriteString("\\end{tabular}\n\\end{center}\n")
+}
+
+// latexLinear escribe una combinación lineal ("3x_{1} + 5x_{2}")
+func latexLinear(coefs []float64, names []string) string {
+	return linearExpression(coefs, names, latexSymbol)
+}
+
+var subscriptName = regexp.MustCompile(`^([A-Za-z]+)(\d+)$`)
+
+// latexSymbol convierte un nombre de variable en símbolo matemático: x1 -> x_{1}, RHS -> \text{RHS}
+func latexSymbol(name string) string {
+	if m := subscriptName.FindStringSubmatch(name); m != nil {
+		return m[1] + "_{" + m[2] + "}"
+	}
+	if len(name) == 1 {
+		return name
+	}
+	return `\text{` + latexEscape(name) + `}`
+}
+
+// latexNumber formatea un número; con Fractions v debe ser el valor exacto, que se
+// muestra como fracción si lo es (denominador hasta 100) y si no truncado a dos
+// decimales como en las tablas
+func latexNumber(v float64, opts LaTeXOptions) string {
+	if opts.Fractions {
+		p, q, ok := simpleFraction(v)
+		switch {
+		case ok && q == 1:
+			return strconv.Itoa(p)
+		case ok:
+			sign := ""
+			if p < 0 {
+				sign, p = "-", -p
+			}
+			return fmt.Sprintf(`%s\frac{%d}{%d}`, sign, p, q)
+		}
+		v = truncateValue(v)
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// simpleFraction busca p/q con el menor denominador (hasta 100) igual a v salvo
+// errores de punto flotante
+func simpleFraction(v float64) (int, int, bool) {
+	for q := 1; q <= 100; q++ {
+		p := math.Round(v * float64(q))
+		if math.Abs(p/float64(q)-v) < 1e-9*math.Max(1, math.Abs(v)) {
+			return int(p), q, true
+		}
+	}
+	return 0, 0, false
+}
+
+// cellValue devuelve el valor de una celda de la tabla: con Fractions el exacto (si
+// el paso lo tiene), para no armar la fracción desde el valor truncado
+func cellValue(step models.TableauStep, i, j int, opts LaTeXOptions) float64 {
+	if opts.Fractions && len(step.Exact) == len(step.Matrix) {
+		return step.Exact[i][j]
+	}
+	return step.Matrix[i][j]
+}
+
+// exactSolution devuelve Z* y los valores de las variables leídos de la última tabla
+// sin truncar, sumando las cotas inferiores si el solver usó x = x' + l. Los valores
+// cuyo truncamiento no coincide con el de la respuesta se dejan como están.
+func exactSolution(req models.SimplexRequest, result models.SimplexResponse) (float64, map[string]float64) {
+	z, values := result.Optimal, maps.Clone(result.Variables)
+	if len(result.TableauxHistory) == 0 {
+		return z, values
+	}
+	last := result.TableauxHistory[len(result.TableauxHistory)-1]
+	if len(last.Exact) == 0 || len(last.Basis) != len(last.Exact) {
+		return z, values
+	}
+
+	rhsCol := len(last.Exact[0]) - 1
+	exactZ := last.Exact[0][rhsCol]
+	exact := make(map[string]float64, len(last.Basis))
+	for i, name := range last.Basis[1:] {
+		exact[name] = last.Exact[i+1][rhsCol]
+	}
+	if shiftedLowerBounds(req) {
+		names := modelVariableNames(req)
+		for j, b := range req.Bounds {
+			if j < len(names) && b.Lower != nil && *b.Lower > 0 {
+				exact[names[j]] += *b.Lower
+				exactZ += req.Objective[j] * *b.Lower
+			}
+		}
+	}
+
+	if truncateValue(exactZ) == z {
+		z = exactZ
+	}
+	for name, v := range values {
+		if truncateValue(exact[name]) == v {
+			values[name] = exact[name]
+		}
+	}
+	return z, values
+}
+
+// truncateValue trunca a dos decimales como las tablas de la respuesta, redondeando
+// antes los errores de punto flotante
+func truncateValue(v float64) float64 {
+	return math.Trunc(math.Round(v*1e6)/1e4)/100 + 0
+}
+
+var latexReplacer = strings.NewReplacer(
+	`\`, `\textbackslash{}`, `{`, `\{`, `}`, `\}`, `_`, `\_`, `%`, `\%`,
+	`$`, `\$`, `&`, `\&`, `#`, `\#`, `^`, `\^{}`, `~`, `\~{}`,
+)
+
+func latexEscape(s string) string {
+	return latexReplacer.Replace(s)
+}
@@ -0,0 +1,242 @@
+package report
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"proyecto/simplex/models"
+
+	"github.com/go-pdf/fpdf"
+)
+
+// methodNames describe el camino usado por el solver (SimplexResponse.Method)
+var methodNames = map[string]string{
+	"primal": "Simplex primal (tabla inicial con variables de holgura)",
+	"dual":   "Simplex dual (hay términos independientes negativos luego de estandarizar)",
+}
+
+// WritePDF escribe un reporte PDF con el enunciado del modelo, el método usado,
+// cada tabla del historial (con el pivote resaltado), la solución óptima y su
+// análisis de sensibilidad.
+func WritePDF(w io.Writer, req models.SimplexRequest, result models.SimplexResponse) error {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	tr := pdf.UnicodeTranslatorFromDescriptor("") // UTF-8 -> cp1252 para las fuentes estándar
+	pdf.SetMargins(15, 15, 15)
+	pdf.SetAutoPageBreak(true, 15)
+	pdf.AddPage()
+
+	heading := func(text string) {
+		pdf.Ln(4)
+		pdf.SetFont("Helvetica", "B", 13)
+		pdf.CellFormat(0, 8, tr(text), "", 1, "L", false, 0, "")
+		pdf.SetFont("Helvetica", "", 10)
+	}
+	line := func(text string) {
+		pdf.CellFormat(0, 6, tr(text), "", 1, "L", false, 0, "")
+	}
+
+	pdf.SetFont("Helvetica", "B", 16)
+	pdf.CellFormat(0, 10, tr("Reporte del método Simplex"), "", 1, "C", false, 0, "")
+
+	// Enunciado del modelo
+	heading("Modelo")
+	names := modelVariableNames(req)
+	plain := func(s string) string { return s }
+	line(fmt.Sprintf("%s Z = %s", req.Type, linearExpression(req.Objective, names, plain)))
+	ops := map[string]string{"le": "<=", "ge": ">=", "eq": "="}
+	for i, row := range req.Constraints {
+		label := "     "
+		if i == 0 {
+			label = "s.a. "
+		}
+		op, rhs := "<=", 0.0
+		if i < len(req.ConstraintTypes) {
+			op = ops[req.ConstraintTypes[i]]
+		}
+		if i < len(req.RHS) {
+			rhs = req.RHS[i]
+		}
+		line(fmt.Sprintf("%s%s %s %s", label, linearExpression(row, names, plain), op, formatNumber(rhs)))
+	}
+	pdf.MultiCell(0, 6, tr(fmt.Sprintf("      %s (%d variables, %d restricciones)", strings.Join(boundTexts(req, names), ", "), len(names), len(req.Constraints))), "", "L", false)
+
+	heading("Método")
+	method, ok := methodNames[result.Method]
+	if !ok {
+		method = "No informado"
+	}
+	line(method)
+
+	// Historial de tablas
+	heading("Tablas")
+	if shiftedLowerBounds(req) {
+		line("Las cotas inferiores positivas se resuelven con x = x' + l: las tablas están en x'.")
+	}
+	for i, step := range result.TableauxHistory {
+		pivotRow, pivotCol := stepPivot(step)
+
+		pdf.SetFont("Helvetica", "B", 10)
+		title := fmt.Sprintf("Iteración %d", i)
+		if pivotRow > 0 {
+			title += fmt.Sprintf(" - entra %s, sale %s (pivote %s)", step.Entering, step.Leaving, formatNumber(step.PivotValue))
+		}
+		pdf.CellFormat(0, 7, tr(title), "", 1, "L", false, 0, "")
+		writePDFTableau(pdf, tr, step, pivotRow, pivotCol)
+		pdf.Ln(3)
+	}
+
+	// Solución
+	heading("Solución")
+	line("Estado: " + result.Status)
+	if len(result.Variables) > 0 {
+		line("Valor óptimo: Z = " + formatNumber(result.Optimal))
+		pdf.Ln(2)
+		pdf.SetFont("Helvetica", "B", 10)
+		pdf.CellFormat(40, 6, "Variable", "1", 0, "C", false, 0, "")
+		pdf.CellFormat(40, 6, "Valor", "1", 1, "C", false, 0, "")
+		pdf.SetFont("Helvetica", "", 10)
+		for _, name := range variableOrder(req, result) {
+			pdf.CellFormat(40, 6, tr(name), "1", 0, "C", false, 0, "")
+			pdf.CellFormat(40, 6, formatNumber(result.Variables[name]), "1", 1, "R", false, 0, "")
+		}
+	}
+
+	if result.Sensitivity != nil {
+		heading("Análisis de sensibilidad")
+		line("Los rangos son los valores entre los que la base óptima no cambia (vacío: sin límite).")
+		pdf.Ln(2)
+		writePDFRows(pdf, tr, variableSensitivityRows(result.Sensitivity))
+		pdf.Ln(4)
+		writePDFRows(pdf, tr, constraintSensitivityRows(result.Sensitivity))
+	}
+
+	return pdf.Output(w)
+}
+
+// writePDFTableau dibuja una tabla con la variable básica de cada fila en la primera
+// columna; la columna entrante y la fila saliente se sombrean y el elemento pivote
+// se resalta con un color más fuerte
+func writePDFTableau(pdf *fpdf.Fpdf, tr func(string) string, step models.TableauStep, pivotRow, pivotCol int) {
+	if len(step.Headers) == 0 {
+		return
+	}
+	hasBasis := len(step.Basis) == len(step.Matrix)
+	numCols := len(step.Headers)
+	if hasBasis {
+		numCols++
+	}
+	width := 180.0 / float64(numCols)
+	if width > 22 {
+		width = 22
+	}
+
+	pdf.SetFont("Helvetica", "B", 8)
+	if hasBasis {
+		pdf.CellFormat(width, 6, "Base", "1", 0, "C", false, 0, "")
+	}
+	for j, h := range step.Headers {
+		fill := j == pivotCol
+		if fill {
+			pdf.SetFillColor(220, 235, 255)
+		}
+		pdf.CellFormat(width, 6, tr(h), "1", 0, "C", fill, 0, "")
+	}
+	pdf.Ln(-1)
+
+	for i, row := range step.Matrix {
+		if hasBasis {
+			pdf.SetFont("Helvetica", "B", 8)
+			pdf.CellFormat(width, 6, tr(step.Basis[i]), "1", 0, "C", false, 0, "")
+		}
+		pdf.SetFont("Helvetica", "", 8)
+		for j, v := range row {
+			fill := true
+			switch {
+			case i == pivotRow && j == pivotCol:
+				pdf.SetFillColor(255, 190, 120)
+			case i == pivotRow:
+				pdf.SetFillColor(255, 228, 205)
+			case j == pivotCol:
+				pdf.SetFillColor(220, 235, 255)
+			default:
+				fill = false
+			}
+			pdf.CellFormat(width, 6, formatNumber(v), "1", 0, "R", fill, 0, "")
+		}
+		pdf.Ln(-1)
+	}
+}
+
+// writePDFRows dibuja una tabla simple: la primera fila es el encabezado, los textos
+// se alinean a la izquierda y los números a la derecha
+func writePDFRows(pdf *fpdf.Fpdf, tr func(string) string, rows [][]any) {
+	for i, row := range rows {
+		style := ""
+		if i == 0 {
+			style = "B"
+		}
+		pdf.SetFont("Helvetica", style, 9)
+		for _, cell := range row {
+			switch v := cell.(type) {
+			case float64:
+				pdf.CellFormat(30, 6, formatNumber(v), "1", 0, "R", false, 0, "")
+			default:
+				pdf.CellFormat(30, 6, tr(fmt.Sprint(v)), "1", 0, "C", false, 0, "")
+			}
+		}
+		pdf.Ln(-1)
+	}
+	pdf.SetFont("Helvetica", "", 10)
+}
+
+// shiftedLowerBounds indica si el solver resolvió el modelo con el cambio de
+// variable x = x' + l (MAX con alguna cota inferior positiva)
+func shiftedLowerBounds(req models.SimplexRequest) bool {
+	if req.Type != "max" {
+		return false
+	}
+	for _, b := range req.Bounds {
+		if b.Lower != nil && *b.Lower > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// boundTexts describe el dominio de cada variable según req.Bounds ("x1 >= 0",
+// "1 <= x2 <= 10", "x3 libre"...); sin cotas todas las variables son no negativas
+func boundTexts(req models.SimplexRequest, names []string) []string {
+	if len(req.Bounds) == 0 {
+		return []string{strings.Join(names, ", ") + " >= 0"}
+	}
+	texts := make([]string, 0, len(names))
+	for j, name := range names {
+		var b models.Bound
+		if j < len(req.Bounds) {
+			b = req.Bounds[j]
+		}
+		lower := "0"
+		if b.Lower != nil {
+			lower = formatNumber(*b.Lower)
+		}
+		switch {
+		case b.Free && b.Upper == nil:
+			texts = append(texts, name+" libre")
+		case b.Free:
+			texts = append(texts, fmt.Sprintf("%s <= %s", name, formatNumber(*b.Upper)))
+		case b.Upper != nil && lower == formatNumber(*b.Upper):
+			texts = append(texts, fmt.Sprintf("%s = %s", name, lower))
+		case b.Upper != nil:
+			texts = append(texts, fmt.Sprintf("%s <= %s <= %s", lower, name, formatNumber(*b.Upper)))
+		default:
+			texts = append(texts, fmt.Sprintf("%s >= %s", name, lower))
+		}
+	}
+	return texts
+}
+
+func formatNumber(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
@@ -0,0 +1,67 @@
+package report
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"proyecto/simplex/models"
+)
+
+// modelVariableNames devuelve el nombre de cada columna del modelo (x1, x2... por defecto)
+func modelVariableNames(req models.SimplexRequest) []string {
+	names := make([]string, len(req.Objective))
+	for j := range names {
+		names[j] = fmt.Sprintf("x%d", j+1)
+		if j < len(req.VariableNames) && req.VariableNames[j] != "" {
+			names[j] = req.VariableNames[j]
+		}
+	}
+	return names
+}
+
+// variableOrder devuelve los nombres de las variables de la solución en el orden de las columnas del modelo
+func variableOrder(req models.SimplexRequest, result models.SimplexResponse) []string {
+	var names []string
+	for _, name := range modelVariableNames(req) {
+		if _, ok := result.Variables[name]; ok {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// linearExpression escribe una combinación lineal ("3x1 + 5x2"); symbol da formato a cada nombre
+func linearExpression(coefs []float64, names []string, symbol func(string) string) string {
+	var sb strings.Builder
+	for j, c := range coefs {
+		if c == 0 || j >= len(names) {
+			continue
+		}
+		switch {
+		case sb.Len() == 0 && c < 0:
+			sb.WriteString("-")
+		case sb.Len() > 0 && c < 0:
+			sb.WriteString(" - ")
+		case sb.Len() > 0:
+			sb.WriteString(" + ")
+		}
+		if a := math.Abs(c); a != 1 {
+			sb.WriteString(strconv.FormatFloat(a, 'g', -1, 64))
+		}
+		sb.WriteString(symbol(names[j]))
+	}
+	if sb.Len() == 0 {
+		return "0"
+	}
+	return sb.String()
+}
+
+// stepPivot devuelve la fila y columna del pivote elegido sobre la tabla, o -1, -1 si no hubo pivoteo
+func stepPivot(step models.TableauStep) (int, int) {
+	if step.PivotRow <= 0 || step.PivotCol <= 0 || step.PivotRow >= len(step.Matrix) {
+		return -1, -1
+	}
+	return step.PivotRow, step.PivotCol
+}
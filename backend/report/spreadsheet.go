@@ -0,0 +1,210 @@
+package report
+
+import (
+	"archive/zip"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+
+	"proyecto/simplex/models"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// summaryRows arma la tabla de resumen: tipo, estado, valor óptimo y variables
+func summaryRows(req models.SimplexRequest, result models.SimplexResponse) [][]any {
+	rows := [][]any{
+		{"Tipo", req.Type},
+		{"Estado", result.Status},
+		{"Óptimo", result.Optimal},
+		{},
+		{"Variable", "Valor"},
+	}
+	for _, name := range variableOrder(req, result) {
+		rows = append(rows, []any{name, result.Variables[name]})
+	}
+	return rows
+}
+
+// sensitivityRows arma la tabla de sensibilidad: costos reducidos y rangos de los
+// coeficientes por variable, y precios sombra y rangos del lado derecho por
+// restricción, separados por una fila vacía. Los extremos infinitos quedan vacíos.
+func sensitivityRows(s *models.Sensitivity) [][]any {
+	rows := append(variableSensitivityRows(s), []any{})
+	return append(rows, constraintSensitivityRows(s)...)
+}
+
+// variableSensitivityRows arma la parte de las variables de la tabla de sensibilidad
+func variableSensitivityRows(s *models.Sensitivity) [][]any {
+	rows := [][]any{
+		{"Variable", "Valor", "Costo reducido", "Coeficiente", "Mínimo", "Máximo"},
+	}
+	for _, v := range s.Variables {
+		rows = append(rows, []any{v.Name, v.Value, v.ReducedCost, v.Coefficient, rangeCell(v.Lower), rangeCell(v.Upper)})
+	}
+	return rows
+}
+
+// constraintSensitivityRows arma la parte de las restricciones de la tabla de sensibilidad
+func constraintSensitivityRows(s *models.Sensitivity) [][]any {
+	rows := [][]any{
+		{"Restricción", "Holgura", "Precio sombra", "Lado derecho", "Mínimo", "Máximo"},
+	}
+	for _, c := range s.Constraints {
+		rows = append(rows, []any{c.Name, c.Slack, c.ShadowPrice, c.RHS, rangeCell(c.Lower), rangeCell(c.Upper)})
+	}
+	return rows
+}
+
+func rangeCell(v *float64) any {
+	if v == nil {
+		return ""
+	}
+	return *v
+}
+
+// stepRows arma la tabla de una iteración: encabezados y filas de la matriz
+func stepRows(step models.TableauStep) [][]any {
+	header := make([]any, len(step.Headers))
+	for j, h := range step.Headers {
+		header[j] = h
+	}
+	rows := [][]any{header}
+	for _, row := range step.Matrix {
+		values := make([]any, len(row))
+		for j, v := range row {
+			values[j] = v
+		}
+		rows = append(rows, values)
+	}
+	return rows
+}
+
+// stepName es el nombre de la hoja o archivo de la iteración i
+func stepName(i int) string {
+	return fmt.Sprintf("Iteración %d", i)
+}
+
+// WriteXLSX escribe un libro con una hoja "Solución", una hoja "Sensibilidad" si la
+// solución es óptima y una hoja por cada tabla del historial (la tabla inicial es la
+// "Iteración 0").
+func WriteXLSX(w io.Writer, req models.SimplexRequest, result models.SimplexResponse) error {
+	f := excelize.NewFile()
+	defer f.Close()
+
+	bold, err := f.NewStyle(&excelize.Style{Font: &excelize.Font{Bold: true}})
+	if err != nil {
+		return err
+	}
+
+	const summary = "Solución"
+	if err := f.SetSheetName("Sheet1", summary); err != nil {
+		return err
+	}
+	if err := writeSheetRows(f, summary, summaryRows(req, result)); err != nil {
+		return err
+	}
+	if err := f.SetCellStyle(summary, "A1", "A3", bold); err != nil {
+		return err
+	}
+	if err := f.SetRowStyle(summary, 5, 5, bold); err != nil {
+		return err
+	}
+
+	if result.Sensitivity != nil {
+		const sheet = "Sensibilidad"
+		if _, err := f.NewSheet(sheet); err != nil {
+			return err
+		}
+		if err := writeSheetRows(f, sheet, sensitivityRows(result.Sensitivity)); err != nil {
+			return err
+		}
+		header := len(result.Sensitivity.Variables) + 3
+		if err := f.SetRowStyle(sheet, 1, 1, bold); err != nil {
+			return err
+		}
+		if err := f.SetRowStyle(sheet, header, header, bold); err != nil {
+			return err
+		}
+	}
+
+	for i, step := range result.TableauxHistory {
+		sheet := stepName(i)
+		if _, err := f.NewSheet(sheet); err != nil {
+			return err
+		}
+		if err := writeSheetRows(f, sheet, stepRows(step)); err != nil {
+			return err
+		}
+		if err := f.SetRowStyle(sheet, 1, 1, bold); err != nil {
+			return err
+		}
+	}
+
+	return f.Write(w)
+}
+
+func writeSheetRows(f *excelize.File, sheet string, rows [][]any) error {
+	for i, row := range rows {
+		cell, err := excelize.CoordinatesToCellName(1, i+1)
+		if err != nil {
+			return err
+		}
+		if err := f.SetSheetRow(sheet, cell, &row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteCSVZip escribe un ZIP con solucion.csv, sensibilidad.csv si la solución es
+// óptima y un CSV por cada tabla del historial (iteracion_0.csv, iteracion_1.csv...).
+func WriteCSVZip(w io.Writer, req models.SimplexRequest, result models.SimplexResponse) error {
+	zw := zip.NewWriter(w)
+
+	if err := writeCSVEntry(zw, "solucion.csv", summaryRows(req, result)); err != nil {
+		return err
+	}
+	if result.Sensitivity != nil {
+		if err := writeCSVEntry(zw, "sensibilidad.csv", sensitivityRows(result.Sensitivity)); err != nil {
+			return err
+		}
+	}
+	for i, step := range result.TableauxHistory {
+		if err := writeCSVEntry(zw, fmt.Sprintf("iteracion_%d.csv", i), stepRows(step)); err != nil {
+			return err
+		}
+	}
+
+	return zw.Close()
+}
+
+func writeCSVEntry(zw *zip.Writer, name string, rows [][]any) error {
+	entry, err := zw.Create(name)
+	if err != nil {
+		return err
+	}
+	cw := csv.NewWriter(entry)
+	for _, row := range rows {
+		record := make([]string, len(row))
+		for j, v := range row {
+			record[j] = formatCell(v)
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func formatCell(v any) string {
+	switch v := v.(type) {
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case string:
+		return v
+	}
+	return fmt.Sprint(v)
+}
@@ -0,0 +1,198 @@
+package report
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"strings"
+
+	"proyecto/simplex/models"
+)
+
+// Tamaño del gráfico SVG en píxeles: el área de dibujo más los márgenes de los rótulos
+const (
+	svgSize   = 640
+	svgMargin = 60
+	svgTicks  = 5
+)
+
+// svgColors son los colores de las rectas de las restricciones (se repiten en orden)
+var svgColors = []string{"#1f77b4", "#2ca02c", "#9467bd", "#8c564b", "#e377c2", "#17becf", "#bcbd22"}
+
+// WriteSVG dibuja el método gráfico de un modelo de 2 variables: la región factible,
+// las rectas de las restricciones, las rectas de nivel de Z (punteadas), el recorrido
+// del Simplex y los vértices óptimos.
+func WriteSVG(w io.Writer, region models.FeasibleRegion) error {
+	if region.Dimension != 2 {
+		return fmt.Errorf("el gráfico SVG necesita 2 variables, el modelo tiene %d", region.Dimension)
+	}
+	bw := bufio.NewWriter(w)
+	plot := svgPlot{min: region.ViewMin, max: region.ViewMax}
+
+	fmt.Fprintf(bw, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="sans-serif" font-size="12">`+"\n",
+		svgSize, svgSize, svgSize, svgSize)
+	bw.WriteString(`<rect width="100%" height="100%" fill="white"/>` + "\n")
+	writeSVGAxes(bw, plot, region.Variables)
+
+	// Región factible
+	var corners []string
+	for _, v := range region.Vertices {
+		x, y := plot.pixel(v.Point)
+		corners = append(corners, fmt.Sprintf("%.1f,%.1f", x, y))
+	}
+	if len(corners) > 0 {
+		fmt.Fprintf(bw, `<polygon points="%s" fill="#cfe3ff" fill-opacity="0.8" stroke="#4a7fc1" stroke-width="1.5"/>`+"\n", strings.Join(corners, " "))
+	}
+
+	// Restricciones (los ejes ya están dibujados)
+	k := 0
+	for _, plane := range region.Constraints {
+		if isAxis(plane) {
+			continue
+		}
+		a, b, ok := plot.clipLine(plane.Coefficients, plane.RHS)
+		if !ok {
+			continue
+		}
+		color := svgColors[k%len(svgColors)]
+		k++
+		fmt.Fprintf(bw, `<line x1="%.1f" y1="%.1f" x2="%.1f" y2="%.1f" stroke="%s" stroke-width="1.5"/>`+"\n", a[0], a[1], b[0], b[1], color)
+		fmt.Fprintf(bw, `<text x="%.1f" y="%.1f" fill="%s">%s</text>`+"\n", b[0]+4, b[1]-4, color, svgEscape(plane.Name))
+	}
+
+	// Rectas de nivel de Z
+	for _, level := range region.IsoLines {
+		a, b, ok := plot.clipLine(region.Objective, level)
+		if !ok {
+			continue
+		}
+		fmt.Fprintf(bw, `<line x1="%.1f" y1="%.1f" x2="%.1f" y2="%.1f" stroke="#888" stroke-width="1" stroke-dasharray="6 4"/>`+"\n", a[0], a[1], b[0], b[1])
+		fmt.Fprintf(bw, `<text x="%.1f" y="%.1f" fill="#666">Z = %s</text>`+"\n", a[0]+4, a[1]-4, formatNumber(level))
+	}
+
+	// Recorrido del Simplex por los vértices
+	var path []string
+	for _, step := range region.Path {
+		if step.Vertex == nil {
+			continue
+		}
+		x, y := plot.pixel(region.Vertices[*step.Vertex].Point)
+		path = append(path, fmt.Sprintf("%.1f,%.1f", x, y))
+		fmt.Fprintf(bw, `<text x="%.1f" y="%.1f" fill="#d95f02" font-weight="bold">%d</text>`+"\n", x-14, y+16, step.Step)
+	}
+	if len(path) > 1 {
+		fmt.Fprintf(bw, `<polyline points="%s" fill="none" stroke="#d95f02" stroke-width="2.5" stroke-opacity="0.8"/>`+"\n", strings.Join(path, " "))
+	}
+
+	// Vértices (los del recorte de la vista no son vértices del modelo)
+	for i, v := range region.Vertices {
+		if v.Clipped {
+			continue
+		}
+		x, y := plot.pixel(v.Point)
+		if slices.Contains(region.OptimalVertices, i) {
+			fmt.Fprintf(bw, `<circle cx="%.1f" cy="%.1f" r="6" fill="#d62728"/>`+"\n", x, y)
+			fmt.Fprintf(bw, `<text x="%.1f" y="%.1f" fill="#d62728" font-weight="bold">Z* = %s (%s, %s)</text>`+"\n",
+				x+8, y-8, formatNumber(v.Objective), formatNumber(v.Point[0]), formatNumber(v.Point[1]))
+			continue
+		}
+		fmt.Fprintf(bw, `<circle cx="%.1f" cy="%.1f" r="3.5" fill="#222"/>`+"\n", x, y)
+		fmt.Fprintf(bw, `<text x="%.1f" y="%.1f" fill="#222" font-size="10">(%s, %s)</text>`+"\n",
+			x+6, y-6, formatNumber(v.Point[0]), formatNumber(v.Point[1]))
+	}
+
+	bw.WriteString("</svg>\n")
+	return bw.Flush()
+}
+
+// svgPlot convierte coordenadas del modelo en píxeles (el eje y crece hacia arriba)
+type svgPlot struct {
+	min, max []float64
+}
+
+func (p svgPlot) pixel(point []float64) (float64, float64) {
+	area := float64(svgSize - 2*svgMargin)
+	x := svgMargin + (point[0]-p.min[0])/(p.max[0]-p.min[0])*area
+	y := svgSize - svgMargin - (point[1]-p.min[1])/(p.max[1]-p.min[1])*area
+	return x, y
+}
+
+// clipLine recorta la recta a·x = b con la vista y devuelve sus extremos en píxeles
+func (p svgPlot) clipLine(a []float64, b float64) ([2]float64, [2]float64, bool) {
+	var ends [][]float64
+	add := func(x, y float64) {
+		tol := 1e-9 * math.Max(1, p.max[0]-p.min[0])
+		if x < p.min[0]-tol || x > p.max[0]+tol || y < p.min[1]-tol || y > p.max[1]+tol {
+			return
+		}
+		for _, e := range ends {
+			if math.Abs(e[0]-x) < tol && math.Abs(e[1]-y) < tol {
+				return
+			}
+		}
+		ends = append(ends, []float64{x, y})
+	}
+	if a[1] != 0 {
+		add(p.min[0], (b-a[0]*p.min[0])/a[1])
+		add(p.max[0], (b-a[0]*p.max[0])/a[1])
+	}
+	if a[0] != 0 {
+		add((b-a[1]*p.min[1])/a[0], p.min[1])
+		add((b-a[1]*p.max[1])/a[0], p.max[1])
+	}
+	if len(ends) < 2 {
+		return [2]float64{}, [2]float64{}, false
+	}
+	x1, y1 := p.pixel(ends[0])
+	x2, y2 := p.pixel(ends[1])
+	return [2]float64{x1, y1}, [2]float64{x2, y2}, true
+}
+
+// writeSVGAxes dibuja la grilla con sus valores, los ejes y el nombre de cada variable
+func writeSVGAxes(bw *bufio.Writer, plot svgPlot, variables []string) {
+	bw.WriteString(`<g stroke="#e5e5e5" stroke-width="1">` + "\n")
+	var labels strings.Builder
+	for k := 0; k <= svgTicks; k++ {
+		vx := plot.min[0] + (plot.max[0]-plot.min[0])*float64(k)/svgTicks
+		vy := plot.min[1] + (plot.max[1]-plot.min[1])*float64(k)/svgTicks
+		x, _ := plot.pixel([]float64{vx, plot.min[1]})
+		_, y := plot.pixel([]float64{plot.min[0], vy})
+		fmt.Fprintf(bw, `<line x1="%.1f" y1="%d" x2="%.1f" y2="%d"/>`+"\n", x, svgMargin, x, svgSize-svgMargin)
+		fmt.Fprintf(bw, `<line x1="%d" y1="%.1f" x2="%d" y2="%.1f"/>`+"\n", svgMargin, y, svgSize-svgMargin, y)
+		fmt.Fprintf(&labels, `<text x="%.1f" y="%d" text-anchor="middle">%s</text>`+"\n", x, svgSize-svgMargin+18, formatNumber(vx))
+		fmt.Fprintf(&labels, `<text x="%d" y="%.1f" text-anchor="end">%s</text>`+"\n", svgMargin-8, y+4, formatNumber(vy))
+	}
+	bw.WriteString("</g>\n")
+	bw.WriteString(labels.String())
+
+	// Ejes x = 0 e y = 0 si están dentro de la vista
+	if plot.min[1] <= 0 && plot.max[1] >= 0 {
+		x1, y := plot.pixel([]float64{plot.min[0], 0})
+		x2, _ := plot.pixel([]float64{plot.max[0], 0})
+		fmt.Fprintf(bw, `<line x1="%.1f" y1="%.1f" x2="%.1f" y2="%.1f" stroke="#000" stroke-width="1.5"/>`+"\n", x1, y, x2, y)
+	}
+	if plot.min[0] <= 0 && plot.max[0] >= 0 {
+		x, y1 := plot.pixel([]float64{0, plot.min[1]})
+		_, y2 := plot.pixel([]float64{0, plot.max[1]})
+		fmt.Fprintf(bw, `<line x1="%.1f" y1="%.1f" x2="%.1f" y2="%.1f" stroke="#000" stroke-width="1.5"/>`+"\n", x, y1, x, y2)
+	}
+	fmt.Fprintf(bw, `<text x="%d" y="%d" text-anchor="end" font-weight="bold">%s</text>`+"\n", svgSize-svgMargin, svgSize-svgMargin+40, svgEscape(variables[0]))
+	fmt.Fprintf(bw, `<text x="%d" y="%d" font-weight="bold">%s</text>`+"\n", 10, svgMargin-16, svgEscape(variables[1]))
+}
+
+// isAxis indica si la restricción es el signo de una variable (x >= 0 o x <= 0)
+func isAxis(plane models.RegionPlane) bool {
+	nonZero := 0
+	for _, c := range plane.Coefficients {
+		if c != 0 {
+			nonZero++
+		}
+	}
+	return nonZero == 1 && plane.RHS == 0
+}
+
+func svgEscape(s string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;").Replace(s)
+}
@@ -0,0 +1,128 @@
+package test
+
+import (
+	"errors"
+	"math"
+	"proyecto/simplex/formats"
+	"proyecto/simplex/logic"
+	"reflect"
+	"testing"
+)
+
+// Test: modelo algebraico del caso básico
+func TestParseAlgebraic_CasoBasico(t *testing.T) {
+	src := `max 3x + 5y
+subject to
+  c1: x <= 4
+  c2: 2y <= 12
+  3*x + 2 y <= 18
+`
+	req, err := formats.ParseAlgebraic(src)
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+
+	if req.Type != "max" {
+		t.Errorf("Tipo incorrecto, got: %v", req.Type)
+	}
+	if !reflect.DeepEqual(req.Objective, []float64{3, 5}) {
+		t.Errorf("Objetivo incorrecto, got: %v", req.Objective)
+	}
+	want := [][]float64{{1, 0}, {0, 2}, {3, 2}}
+	if !reflect.DeepEqual(req.Constraints, want) {
+		t.Errorf("Restricciones incorrectas, got: %v", req.Constraints)
+	}
+	if !reflect.DeepEqual(req.RHS, []float64{4, 12, 18}) {
+		t.Errorf("RHS incorrecto, got: %v", req.RHS)
+	}
+	if !reflect.DeepEqual(req.ConstraintNames, []string{"c1", "c2", "c3"}) {
+		t.Errorf("Nombres incorrectos, got: %v", req.ConstraintNames)
+	}
+
+	result, err := logic.SolveRequest(req)
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	if math.Abs(result.Optimal-36.0) > 1e-6 {
+		t.Errorf("Valor óptimo incorrecto, got: %v, want: 36.0", result.Optimal)
+	}
+	if math.Abs(result.Variables["x"]-2.0) > 1e-6 || math.Abs(result.Variables["y"]-6.0) > 1e-6 {
+		t.Errorf("Variables incorrectas, got: %v", result.Variables)
+	}
+}
+
+// Test: variables a ambos lados, cotas y declaraciones enteras
+func TestParseAlgebraic_CotasYEnteras(t *testing.T) {
+	src := `min 60a + 80b
+s.t.
+  6a + 5b >= 50
+  2a >= 30 - 5b ; a - b = 1
+bounds
+  b <= 8
+  1 <= a <= 10
+int a, b
+end`
+	req, err := formats.ParseAlgebraic(src)
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+
+	if !reflect.DeepEqual(req.ConstraintTypes, []string{"ge", "ge", "eq"}) {
+		t.Errorf("Tipos incorrectos, got: %v", req.ConstraintTypes)
+	}
+	if !reflect.DeepEqual(req.Constraints[1], []float64{2, 5}) || req.RHS[1] != 30 {
+		t.Errorf("Restricción con variables a ambos lados incorrecta, got: %v <= %v", req.Constraints[1], req.RHS[1])
+	}
+	if len(req.Bounds) != 2 || *req.Bounds[0].Lower != 1 || *req.Bounds[0].Upper != 10 || *req.Bounds[1].Upper != 8 {
+		t.Errorf("Cotas incorrectas, got: %+v", req.Bounds)
+	}
+	if !reflect.DeepEqual(req.Integer, []bool{true, true}) {
+		t.Errorf("Variables enteras incorrectas, got: %v", req.Integer)
+	}
+}
+
+// Test: los errores de sintaxis informan línea y columna
+func TestParseAlgebraic_ErrorDeSintaxis(t *testing.T) {
+	cases := []struct {
+		src          string
+		line, column int
+	}{
+		{"maximize 3x + 5y\nsubject to\n  x + y <= 4\n  2x + $y <= 3\n", 4, 8},
+		{"max 3x + 5y\n  x + y 4\n", 2, 9},
+		{"3x + 5y\n", 1, 1},
+		{"max x\nst\n  x <= 4\nbounds\n  z <= 3\n", 5, 3},
+		// "2e1" podría ser 20 o 2·e1: se exige separar o escribir el exponente con signo
+		{"max 2e1 + 3e2\nst\n  e1 + e2 <= 4\n", 1, 5},
+	}
+
+	for _, tc := range cases {
+		_, err := formats.ParseAlgebraic(tc.src)
+		var syntaxErr *formats.SyntaxError
+		if !errors.As(err, &syntaxErr) {
+			t.Errorf("Se esperaba SyntaxError para %q, got: %v", tc.src, err)
+			continue
+		}
+		if syntaxErr.Line != tc.line || syntaxErr.Column != tc.column {
+			t.Errorf("Posición incorrecta para %q, got: %d:%d, want: %d:%d (%v)", tc.src, syntaxErr.Line, syntaxErr.Column, tc.line, tc.column, err)
+		}
+	}
+}
+
+// Test: la e pegada a un número solo es exponente si lleva signo
+func TestParseAlgebraic_Exponente(t *testing.T) {
+	src := "max 2e+1 x + 2 e1 + 3*e2\nst\n  x + e1 + e2 <= 1.5e-1\n"
+	req, err := formats.ParseAlgebraic(src)
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+
+	if !reflect.DeepEqual(req.VariableNames, []string{"x", "e1", "e2"}) {
+		t.Errorf("Variables incorrectas, got: %v", req.VariableNames)
+	}
+	if !reflect.DeepEqual(req.Objective, []float64{20, 2, 3}) {
+		t.Errorf("Objetivo incorrecto, got: %v", req.Objective)
+	}
+	if !reflect.DeepEqual(req.RHS, []float64{0.15}) {
+		t.Errorf("RHS incorrecto, got: %v", req.RHS)
+	}
+}
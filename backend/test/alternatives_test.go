@@ -0,0 +1,82 @@
+package test
+
+import (
+	"proyecto/simplex/logic"
+	"proyecto/simplex/models"
+	"reflect"
+	"testing"
+)
+
+// Test: max x1 + x2 con x1 + x2 <= 4 tiene un segmento de soluciones óptimas
+func TestSolveRequest_OptimosAlternativos(t *testing.T) {
+	req := models.SimplexRequest{
+		Objective:       []float64{1, 1},
+		Constraints:     [][]float64{{1, 1}, {1, 0}},
+		RHS:             []float64{4, 3},
+		Type:            "max",
+		ConstraintTypes: []string{"le", "le"},
+		Alternatives:    true,
+	}
+
+	result, err := logic.SolveRequest(req)
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	if !result.AlternativeOptima || !reflect.DeepEqual(result.ZeroReducedCost, []string{"s2"}) {
+		t.Errorf("Detección incorrecta: alternativos %v, costo reducido 0 en %v", result.AlternativeOptima, result.ZeroReducedCost)
+	}
+
+	want := []map[string]float64{{"x1": 3, "x2": 1}, {"x1": 0, "x2": 4}}
+	if result.OptimalFace == nil || !reflect.DeepEqual(result.OptimalFace.Vertices, want) {
+		t.Fatalf("Vértices óptimos incorrectos, got: %+v", result.OptimalFace)
+	}
+	if !result.OptimalFace.Complete || len(result.OptimalFace.Rays) != 0 {
+		t.Errorf("Cara óptima incorrecta: %+v", result.OptimalFace)
+	}
+	if len(result.Alternatives) != 2 || !reflect.DeepEqual(result.Alternatives[1].Basis, []string{"x2", "s2"}) {
+		t.Errorf("Soluciones básicas incorrectas: %+v", result.Alternatives)
+	}
+
+	// Con límite 1 la enumeración queda incompleta
+	req.MaxAlternatives = 1
+	result, _ = logic.SolveRequest(req)
+	if len(result.Alternatives) != 1 || result.OptimalFace.Complete {
+		t.Errorf("Se esperaba una sola solución y la cara incompleta: %+v", result.OptimalFace)
+	}
+}
+
+// Test: si la variable con costo reducido 0 puede crecer sin límite la cara tiene un rayo
+func TestSolveRequest_CaraOptimaNoAcotada(t *testing.T) {
+	req := models.SimplexRequest{
+		Objective:       []float64{0, 1},
+		Constraints:     [][]float64{{0, 1}, {-1, 1}},
+		RHS:             []float64{2, 1},
+		Type:            "max",
+		ConstraintTypes: []string{"le", "le"},
+		VariableNames:   []string{"a", "b"},
+		Alternatives:    true,
+	}
+
+	result, err := logic.SolveRequest(req)
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	face := result.OptimalFace
+	if face == nil || len(face.Vertices) != 1 || !reflect.DeepEqual(face.Rays, []map[string]float64{{"a": 1, "b": 0}}) {
+		t.Errorf("Cara óptima incorrecta: %+v", face)
+	}
+}
+
+// Test: con solución única no se marcan óptimos alternativos
+func TestSolveRequest_SolucionUnica(t *testing.T) {
+	req, _ := casoBasico(t)
+	req.Alternatives = true
+
+	result, err := logic.SolveRequest(req)
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	if result.AlternativeOptima || len(result.Alternatives) != 1 || result.OptimalFace.Description != "La solución óptima es única." {
+		t.Errorf("Se esperaba solución única: %+v", result.OptimalFace)
+	}
+}
@@ -0,0 +1,103 @@
+package test
+
+import (
+	"proyecto/simplex/logic"
+	"proyecto/simplex/models"
+	"testing"
+)
+
+// casoAsignacion es un ejemplo de 4 agentes y 4 tareas (óptimo 140) que necesita
+// ajustar la matriz después de las reducciones
+func casoAsignacion() models.AssignmentRequest {
+	return models.AssignmentRequest{
+		Costs: [][]float64{
+			{82, 83, 69, 92},
+			{77, 37, 49, 92},
+			{11, 69, 5, 86},
+			{8, 9, 98, 23},
+		},
+	}
+}
+
+// Test: minimización con reducciones, cubrimiento y ajuste
+func TestSolveAssignment_Minimizacion(t *testing.T) {
+	response, err := logic.SolveAssignment(casoAsignacion())
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	if response.Status != models.StatusOptimal || response.Total != 140 || len(response.Assignments) != 4 {
+		t.Fatalf("Se esperaba el óptimo 140, got %v %v %+v", response.Status, response.Total, response.Assignments)
+	}
+	expected := []int{2, 1, 0, 3}
+	for i, a := range response.Assignments {
+		if a.Row != i || a.Col != expected[i] || a.Cost != casoAsignacion().Costs[i][a.Col] {
+			t.Errorf("Asignación %d incorrecta: %+v", i, a)
+		}
+	}
+	if response.Steps[0].Kind != models.AssignmentRowReduction || response.Steps[1].Kind != models.AssignmentColumnReduction {
+		t.Errorf("Los primeros pasos deben ser las reducciones: %+v", response.Steps[:2])
+	}
+	var adjusted bool
+	for _, step := range response.Steps {
+		if step.Kind == models.AssignmentAdjust && step.Value > 0 && len(step.CoveredRows)+len(step.CoveredColumns) < 4 {
+			adjusted = true
+		}
+	}
+	if !adjusted {
+		t.Errorf("Se esperaba al menos un ajuste con menos de 4 líneas: %+v", response.Steps)
+	}
+}
+
+// Test: matriz rectangular de ganancias y celdas prohibidas
+func TestSolveAssignment_MaximizacionYProhibidas(t *testing.T) {
+	response, err := logic.SolveAssignment(models.AssignmentRequest{
+		Costs:    [][]float64{{10, 5, 8}, {7, 9, 6}},
+		Type:     "max",
+		RowNames: []string{"Ana", "Luis"},
+	})
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	if len(response.Rows) != 3 || response.Total != 19 || len(response.Unassigned) != 1 || response.Unassigned[0] != "T3" {
+		t.Errorf("Se esperaba la ganancia 19 con T3 sin asignar: %+v", response)
+	}
+	if response.Assignments[0].RowName != "Ana" || response.Assignments[0].ColumnName != "T1" {
+		t.Errorf("Ana debería hacer T1: %+v", response.Assignments)
+	}
+
+	// Con A1-T1 prohibida conviene A1-T2 y A2-T1
+	response, err = logic.SolveAssignment(models.AssignmentRequest{
+		Costs:     [][]float64{{1, 4}, {3, 9}},
+		Forbidden: []models.AssignmentCell{{Row: 0, Col: 0}},
+	})
+	if err != nil || response.Total != 7 || response.Matrix[0][0] != nil {
+		t.Errorf("Se esperaba el costo 7 sin usar la celda prohibida: %+v %v", response, err)
+	}
+
+	// Las dos filas solo pueden hacer T1
+	response, err = logic.SolveAssignment(models.AssignmentRequest{
+		Costs:     [][]float64{{1, 4}, {3, 9}},
+		Forbidden: []models.AssignmentCell{{Row: 0, Col: 1}, {Row: 1, Col: 1}},
+	})
+	if err != nil || response.Status != models.StatusInfeasible || response.Assignments != nil {
+		t.Errorf("Se esperaba una asignación infactible: %+v %v", response, err)
+	}
+}
+
+// Test: al maximizar, una celda prohibida no se puede elegir aunque tenga la mayor ganancia
+func TestSolveAssignment_MaximizacionConProhibida(t *testing.T) {
+	response, err := logic.SolveAssignment(models.AssignmentRequest{
+		Costs:     [][]float64{{10, 2}, {6, 1}},
+		Type:      "max",
+		Forbidden: []models.AssignmentCell{{Row: 0, Col: 0}},
+	})
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	if response.Status != models.StatusOptimal || response.Total != 8 || response.Matrix[0][0] != nil {
+		t.Fatalf("Se esperaba la ganancia 8 sin usar la celda prohibida: %+v", response)
+	}
+	if response.Assignments[0].Col != 1 || response.Assignments[1].Col != 0 {
+		t.Errorf("Se esperaba A1-T2 y A2-T1: %+v", response.Assignments)
+	}
+}
@@ -0,0 +1,77 @@
+package test
+
+import (
+	"proyecto/simplex/logic"
+	"proyecto/simplex/models"
+	"strings"
+	"testing"
+)
+
+// Test: max x1 + x2 con x1 - x2 <= 0 arranca con un pivote de cociente 0
+func TestSolveRequest_PivoteDegenerado(t *testing.T) {
+	req := models.SimplexRequest{
+		Objective:       []float64{1, 1},
+		Constraints:     [][]float64{{1, -1}, {1, 0}, {0, 1}},
+		RHS:             []float64{0, 2, 3},
+		Type:            "max",
+		ConstraintTypes: []string{"le", "le", "le"},
+		Explain:         true,
+	}
+
+	result, err := logic.SolveRequest(req)
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	if result.Optimal != 5 {
+		t.Errorf("Valor óptimo incorrecto, got: %v, want: 5", result.Optimal)
+	}
+
+	first := result.TableauxHistory[0]
+	if !first.DegenerateBasis || !first.DegeneratePivot {
+		t.Errorf("La tabla inicial debería ser degenerada con pivote degenerado: %+v", first)
+	}
+	if first.Objective != result.TableauxHistory[1].Objective {
+		t.Errorf("Un pivote degenerado no debería cambiar Z: %v -> %v", first.Objective, result.TableauxHistory[1].Objective)
+	}
+	if !strings.Contains(first.Explanation, "pivote es degenerado") {
+		t.Errorf("La explicación debería mencionar el pivote degenerado: %q", first.Explanation)
+	}
+
+	count := 0
+	for _, step := range result.TableauxHistory {
+		if step.DegeneratePivot {
+			count++
+		}
+	}
+	if result.DegenerateIterations != count || count != 1 {
+		t.Errorf("Cantidad de iteraciones degeneradas incorrecta: %d (pasos marcados: %d)", result.DegenerateIterations, count)
+	}
+	if result.Degenerate {
+		t.Error("La base óptima no es degenerada")
+	}
+}
+
+// Test: una base óptima degenerada se informa en la respuesta y en el mensaje
+func TestSolveRequest_OptimoDegenerado(t *testing.T) {
+	req := models.SimplexRequest{
+		Objective:       []float64{3, 5},
+		Constraints:     [][]float64{{1, 0}, {0, 2}, {3, 2}, {0, 1}},
+		RHS:             []float64{4, 12, 18, 6},
+		Type:            "max",
+		ConstraintTypes: []string{"le", "le", "le", "le"},
+	}
+
+	result, err := logic.SolveRequest(req)
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	if !result.Degenerate || !result.TableauxHistory[len(result.TableauxHistory)-1].DegenerateBasis {
+		t.Error("La base óptima debería marcarse como degenerada")
+	}
+	if !strings.Contains(result.Message, "unilaterales") {
+		t.Errorf("El mensaje debería advertir sobre los rangos de sensibilidad: %q", result.Message)
+	}
+	if v2 := result.V2(); !v2.Degenerate {
+		t.Error("La respuesta v2 debería marcar la degeneración")
+	}
+}
@@ -0,0 +1,146 @@
+package test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"proyecto/simplex/handlers"
+	"proyecto/simplex/logic"
+	"proyecto/simplex/models"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Test: dual del caso básico (max con <=): min b·y con A^T y >= c, y >= 0
+func TestBuildDual_CasoBasico(t *testing.T) {
+	req := models.SimplexRequest{
+		Objective:       []float64{3, 5},
+		Constraints:     [][]float64{{1, 0}, {0, 2}, {3, 2}},
+		RHS:             []float64{4, 12, 18},
+		Type:            "max",
+		ConstraintTypes: []string{"le", "le", "le"},
+	}
+
+	response, err := logic.BuildDual(req, true)
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+
+	dual := response.Dual
+	if dual.Type != "min" || !reflect.DeepEqual(dual.Objective, []float64{4, 12, 18}) || !reflect.DeepEqual(dual.RHS, []float64{3, 5}) {
+		t.Errorf("Dual incorrecto: %+v", dual)
+	}
+	if !reflect.DeepEqual(dual.Constraints, [][]float64{{1, 0, 3}, {0, 2, 2}}) || !reflect.DeepEqual(dual.ConstraintTypes, []string{"ge", "ge"}) {
+		t.Errorf("Restricciones duales incorrectas: %v %v", dual.Constraints, dual.ConstraintTypes)
+	}
+	if !reflect.DeepEqual(dual.VariableNames, []string{"y1", "y2", "y3"}) || !reflect.DeepEqual(dual.ConstraintNames, []string{"x1", "x2"}) || dual.Bounds != nil {
+		t.Errorf("Nombres o cotas incorrectos: %v %v %v", dual.VariableNames, dual.ConstraintNames, dual.Bounds)
+	}
+
+	check := response.Check
+	if check == nil || *check.Primal.Optimal != 36 || *check.Dual.Optimal != 36 || !check.StrongDuality || !check.ComplementarySlackness {
+		t.Fatalf("Verificación incorrecta: %+v", check)
+	}
+	if check.Dual.Variables["y1"] != 0 || check.Dual.Variables["y2"] != 1.5 || check.Dual.Variables["y3"] != 1 {
+		t.Errorf("Precios sombra incorrectos: %v", check.Dual.Variables)
+	}
+	if len(check.Violations) != 0 || check.Message != "Se cumplen la dualidad fuerte y la holgura complementaria." {
+		t.Errorf("No se esperaban violaciones: %v (%q)", check.Violations, check.Message)
+	}
+}
+
+// Test: min con igualdad y variable libre; el dual del dual es el primal
+func TestBuildDual_IgualdadYVariableLibre(t *testing.T) {
+	req := models.SimplexRequest{
+		Objective:       []float64{2, 3},
+		Constraints:     [][]float64{{1, 1}, {1, -1}},
+		RHS:             []float64{4, 1},
+		Type:            "min",
+		ConstraintTypes: []string{"ge", "eq"},
+		Bounds:          []models.Bound{{}, {Free: true}},
+	}
+
+	response, err := logic.BuildDual(req, true)
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	if response.Dual.Type != "max" || !reflect.DeepEqual(response.Dual.ConstraintTypes, []string{"le", "eq"}) {
+		t.Errorf("Dual incorrecto: %+v", response.Dual)
+	}
+	if !reflect.DeepEqual(response.VariableSigns, []models.VariableSign{models.SignNonNegative, models.SignFree}) {
+		t.Errorf("Signos incorrectos: %v", response.VariableSigns)
+	}
+
+	check := response.Check
+	if *check.Primal.Optimal != 9.5 || *check.Dual.Optimal != 9.5 || !check.StrongDuality || !check.ComplementarySlackness {
+		t.Errorf("Verificación incorrecta: %+v", check)
+	}
+	if check.Primal.Variables["x1"] != 2.5 || check.Primal.Variables["x2"] != 1.5 || check.Dual.Variables["y2"] != -0.5 {
+		t.Errorf("Soluciones incorrectas: %v %v", check.Primal.Variables, check.Dual.Variables)
+	}
+
+	again, err := logic.BuildDual(response.Dual, false)
+	if err != nil {
+		t.Fatalf("Error inesperado al dualizar el dual: %v", err)
+	}
+	primal := again.Dual
+	if primal.Type != "min" || !reflect.DeepEqual(primal.Objective, req.Objective) || !reflect.DeepEqual(primal.Constraints, req.Constraints) ||
+		!reflect.DeepEqual(primal.ConstraintTypes, req.ConstraintTypes) || !reflect.DeepEqual(again.VariableSigns, []models.VariableSign{models.SignNonNegative, models.SignFree}) {
+		t.Errorf("El dual del dual no es el primal: %+v", primal)
+	}
+}
+
+// Test: primal infactible y dual ilimitado no es una violación
+func TestBuildDual_InfactibleIlimitado(t *testing.T) {
+	req := models.SimplexRequest{
+		Objective:       []float64{1, 1},
+		Constraints:     [][]float64{{1, 1}},
+		RHS:             []float64{-1},
+		Type:            "max",
+		ConstraintTypes: []string{"le"},
+		Language:        "en",
+	}
+
+	response, err := logic.BuildDual(req, true)
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	check := response.Check
+	if check.Primal.Status != models.StatusInfeasible || check.Dual.Status != models.StatusUnbounded || check.Primal.Optimal != nil {
+		t.Errorf("Estados incorrectos: %+v", check)
+	}
+	if len(check.Violations) != 0 || check.StrongDuality || check.Message != "One problem is unbounded and the other infeasible, as weak duality predicts." {
+		t.Errorf("Verificación incorrecta: %+v", check)
+	}
+}
+
+// Test: endpoint /api/simplex/dual
+func TestDualHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.POST("/api/simplex/dual", handlers.DualHandler)
+
+	body := `{"objective":[3,5],"constraints":[[1,0],[0,2],[3,2]],"rhs":[4,12,18],"type":"max","constraint_types":["le","eq","ge"]}`
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/simplex/dual?solve=true", bytes.NewBufferString(body)))
+	if w.Code != http.StatusOK {
+		t.Fatalf("Se esperaba 200, got %d: %s", w.Code, w.Body.String())
+	}
+	var response models.DualResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+		t.Fatalf("Respuesta inválida: %v", err)
+	}
+	want := []models.VariableSign{models.SignNonNegative, models.SignFree, models.SignNonPositive}
+	if !reflect.DeepEqual(response.VariableSigns, want) || len(response.Dual.Bounds) != 3 || response.Check == nil {
+		t.Errorf("Respuesta incorrecta: %+v", response)
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/simplex/dual", bytes.NewBufferString(`{"objective":[1],"constraints":[[1]],"rhs":[1],"type":"max","constraint_types":["lt"]}`)))
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Se esperaba 400, got %d: %s", w.Code, w.Body.String())
+	}
+}
@@ -0,0 +1,134 @@
+package test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"proyecto/simplex/handlers"
+	"proyecto/simplex/logic"
+	"proyecto/simplex/models"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Test: la validación informa todos los problemas con la ruta de cada campo
+func TestValidateRequest_TodosLosErrores(t *testing.T) {
+	req := models.SimplexRequest{
+		Objective:       []float64{1, math.NaN()},
+		Constraints:     [][]float64{{1, 1}, {2, 0}, {1, math.Inf(1)}},
+		RHS:             []float64{5, 4},
+		Type:            "maximo",
+		ConstraintTypes: []string{"le", "eq", "ge"},
+	}
+
+	err := logic.ValidateRequest(req)
+	var errs logic.ValidationErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("Se esperaba ValidationErrors, got: %v", err)
+	}
+
+	var fields []string
+	for _, fe := range errs {
+		fields = append(fields, fe.Field)
+	}
+	want := []string{"type", "rhs", "objective[1]", "constraints[2][1]", "constraint_types[1]"}
+	if len(fields) != len(want) {
+		t.Fatalf("Campos incorrectos, got: %v, want: %v", fields, want)
+	}
+	for i := range want {
+		if fields[i] != want[i] {
+			t.Errorf("Campo %d incorrecto, got: %q, want: %q", i, fields[i], want[i])
+		}
+	}
+	if errs[1].Details["expected"] != 3 || errs[1].Details["got"] != 2 {
+		t.Errorf("Detalles incorrectos para rhs: %v", errs[1].Details)
+	}
+	if errs.Unsupported() {
+		t.Error("Un modelo con errores de datos no debería considerarse solo no soportado")
+	}
+	if !errs[4].Unsupported() {
+		t.Error("La restricción de igualdad debería marcarse como no soportada")
+	}
+}
+
+// postSimplex envía el modelo a /api/simplex y devuelve el código y el cuerpo de error
+func postSimplex(t *testing.T, body string) (int, models.ErrorResponse) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.POST("/api/simplex", handlers.SolveSimplexHandler)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/simplex", bytes.NewBufferString(body)))
+
+	var resp models.ErrorResponse
+	if w.Code != http.StatusOK {
+		if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("Cuerpo de error inválido: %v (%s)", err, w.Body.String())
+		}
+	}
+	return w.Code, resp
+}
+
+// Test: los errores usan el sobre {"error": {code, message, field, details}} y el código HTTP adecuado
+func TestSolveSimplexHandler_Errores(t *testing.T) {
+	// Un solo error de datos: 400 con el campo
+	code, resp := postSimplex(t, `{"objective":[1,2],"constraints":[[1,1]],"rhs":[1,2],"type":"max","constraint_types":["le"]}`)
+	if code != http.StatusBadRequest || resp.Error.Code != "rhs_length" || resp.Error.Field != "rhs" {
+		t.Errorf("Respuesta incorrecta: %d %+v", code, resp.Error)
+	}
+
+	// Varios errores: se listan todos en details.errors
+	code, resp = postSimplex(t, `{"objective":[],"constraints":[[1,1]],"rhs":[1],"type":"x","constraint_types":["le"]}`)
+	if code != http.StatusBadRequest || resp.Error.Code != "invalid_model" {
+		t.Errorf("Respuesta incorrecta: %d %+v", code, resp.Error)
+	}
+	if items, ok := resp.Error.Details["errors"].([]any); !ok || len(items) != 2 {
+		t.Errorf("Se esperaban 2 errores en details, got: %v", resp.Error.Details)
+	}
+
+	// Característica no soportada: 422
+	code, resp = postSimplex(t, `{"objective":[1,2],"constraints":[[1,1]],"rhs":[1],"type":"max","constraint_types":["eq"]}`)
+	if code != http.StatusUnprocessableEntity || resp.Error.Code != "equality_unsupported" || resp.Error.Field != "constraint_types[0]" {
+		t.Errorf("Respuesta incorrecta: %d %+v", code, resp.Error)
+	}
+
+	// JSON inválido
+	code, resp = postSimplex(t, `{"objective":`)
+	if code != http.StatusBadRequest || resp.Error.Code != "invalid_json" {
+		t.Errorf("Respuesta incorrecta: %d %+v", code, resp.Error)
+	}
+}
+
+// Test: los errores de sintaxis se traducen según Accept-Language y conservan la posición
+func TestSolveTextHandler_ErrorTraducido(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.POST("/api/simplex/text", handlers.SolveTextHandler)
+
+	cases := map[string]string{
+		"en": "line 1, column 9: expected a number or a variable, found end of line",
+		"es": "línea 1, columna 9: se esperaba un número o una variable, se encontró fin de línea",
+	}
+	for lang, want := range cases {
+		req := httptest.NewRequest(http.MethodPost, "/api/simplex/text", bytes.NewBufferString("max 3x +\nst\n x <= 4\n"))
+		req.Header.Set("Content-Type", "text/plain")
+		req.Header.Set("Accept-Language", lang)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		var resp models.ErrorResponse
+		if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("Cuerpo de error inválido: %v (%s)", err, w.Body.String())
+		}
+		if w.Code != http.StatusBadRequest || resp.Error.Code != "syntax_error" || resp.Error.Message != want {
+			t.Errorf("Respuesta incorrecta (%s): %d %+v", lang, w.Code, resp.Error)
+		}
+		if resp.Error.Details["reason"] != "syntax_expected_term" {
+			t.Errorf("Código del catálogo incorrecto (%s): %v", lang, resp.Error.Details)
+		}
+	}
+}
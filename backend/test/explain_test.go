@@ -0,0 +1,77 @@
+package test
+
+import (
+	"proyecto/simplex/logic"
+	"proyecto/simplex/models"
+	"strings"
+	"testing"
+)
+
+// Test: con explain cada tabla explica la variable entrante, la prueba del cociente y la saliente
+func TestSolveRequest_Explicacion(t *testing.T) {
+	req := models.SimplexRequest{
+		Objective:       []float64{3, 5},
+		Constraints:     [][]float64{{1, 0}, {0, 2}, {3, 2}},
+		RHS:             []float64{4, 12, 18},
+		Type:            "max",
+		ConstraintTypes: []string{"le", "le", "le"},
+		Explain:         true,
+	}
+
+	result, err := logic.SolveRequest(req)
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	steps := result.TableauxHistory
+	if len(steps) != 3 {
+		t.Fatalf("Se esperaban 3 tablas, got: %d", len(steps))
+	}
+
+	want := "Entra x2 porque tiene el coeficiente más negativo (-5) en la fila Z. " +
+		"Prueba del cociente: 4/0 = ∞, 12/2 = 6, 18/2 = 9, así que sale s2 (menor cociente 6)."
+	if !strings.HasPrefix(steps[0].Explanation, want) {
+		t.Errorf("Explicación incorrecta, got: %q", steps[0].Explanation)
+	}
+	if !strings.Contains(steps[2].Explanation, "óptima (Z = 36)") {
+		t.Errorf("La última tabla debería explicar la optimalidad, got: %q", steps[2].Explanation)
+	}
+
+	req.Language = "en"
+	result, err = logic.SolveRequest(req)
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	if got := result.TableauxHistory[0].Explanation; !strings.HasPrefix(got, "x2 enters because it has the most negative coefficient (-5)") ||
+		!strings.Contains(got, "so s2 leaves") {
+		t.Errorf("Explicación en inglés incorrecta, got: %q", got)
+	}
+
+	req.Explain = false
+	result, _ = logic.SolveRequest(req)
+	if result.TableauxHistory[0].Explanation != "" {
+		t.Errorf("Sin explain no debería haber explicación, got: %q", result.TableauxHistory[0].Explanation)
+	}
+}
+
+// Test: en el Simplex dual se explica la fila saliente y los cocientes por columna
+func TestSolveRequest_ExplicacionDual(t *testing.T) {
+	req := models.SimplexRequest{
+		Objective:       []float64{2, 3},
+		Constraints:     [][]float64{{1, 1}, {1, 2}},
+		RHS:             []float64{4, 6},
+		Type:            "min",
+		ConstraintTypes: []string{"ge", "ge"},
+		Explain:         true,
+	}
+
+	result, err := logic.SolveRequest(req)
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	got := result.TableauxHistory[0].Explanation
+	for _, want := range []string{"Sale s2", "(-6)", "x2: |-3/-2| = 1.5", "entra x2"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Falta %q en la explicación: %q", want, got)
+		}
+	}
+}
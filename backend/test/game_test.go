@@ -0,0 +1,65 @@
+package test
+
+import (
+	"proyecto/simplex/logic"
+	"proyecto/simplex/models"
+	"slices"
+	"testing"
+)
+
+// Test: juego con punto de silla (estrategias puras, sin programas lineales)
+func TestSolveGame_PuntoDeSilla(t *testing.T) {
+	response, err := logic.SolveGame(models.GameRequest{Payoffs: [][]float64{{1, 2}, {0, 3}}})
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	if len(response.SaddlePoints) != 1 || response.Value != 1 || response.Maximin != 1 || response.Minimax != 1 {
+		t.Errorf("Se esperaba el punto de silla (A1, B1) con valor 1: %+v", response)
+	}
+	if !slices.Equal(response.RowStrategy, []float64{1, 0}) || !slices.Equal(response.ColumnStrategy, []float64{1, 0}) || response.RowLP != nil {
+		t.Errorf("Se esperaban estrategias puras: %+v", response)
+	}
+}
+
+// Test: piedra, papel o tijera (desplazamiento y estrategias mixtas)
+func TestSolveGame_EstrategiasMixtas(t *testing.T) {
+	response, err := logic.SolveGame(models.GameRequest{Payoffs: [][]float64{{0, -1, 1}, {1, 0, -1}, {-1, 1, 0}}})
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	third := []float64{0.33, 0.33, 0.33}
+	if response.Value != 0 || response.Shift != 2 || !slices.Equal(response.RowStrategy, third) || !slices.Equal(response.ColumnStrategy, third) {
+		t.Errorf("Se esperaba valor 0 con estrategias (1/3, 1/3, 1/3): %+v", response)
+	}
+	if response.RowLP.Model.Type != "min" || response.ColumnLP.Model.Type != "max" || len(response.ColumnLP.Solution.TableauxHistory) < 2 {
+		t.Errorf("Se esperaba un programa lineal por jugador resuelto con el simplex: %+v %+v", response.RowLP.Model, response.ColumnLP.Model)
+	}
+}
+
+// Test: eliminación de estrategias dominadas antes de resolver
+func TestSolveGame_Dominancia(t *testing.T) {
+	response, err := logic.SolveGame(models.GameRequest{Payoffs: [][]float64{{3, -1, 5}, {-2, 4, 6}}})
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	if len(response.Dominances) != 1 || response.Dominances[0].Player != models.PlayerColumn || response.Dominances[0].Removed != 2 {
+		t.Errorf("Se esperaba eliminar B3 (dominada por B1): %+v", response.Dominances)
+	}
+	if response.Value != 1 || !slices.Equal(response.RowStrategy, []float64{0.6, 0.4}) || !slices.Equal(response.ColumnStrategy, []float64{0.5, 0.5, 0}) {
+		t.Errorf("Se esperaba valor 1 con (0.6, 0.4) y (0.5, 0.5, 0): %+v", response)
+	}
+}
+
+// Test: una fila dominada por otra se elimina y queda con probabilidad 0
+func TestSolveGame_DominanciaDeFila(t *testing.T) {
+	response, err := logic.SolveGame(models.GameRequest{Payoffs: [][]float64{{3, 1}, {1, 3}, {0, 0}}})
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	if len(response.Dominances) != 1 || response.Dominances[0].Player != models.PlayerRow || response.Dominances[0].Removed != 2 {
+		t.Errorf("Se esperaba eliminar A3 (dominada por A1): %+v", response.Dominances)
+	}
+	if response.Value != 2 || !slices.Equal(response.RowStrategy, []float64{0.5, 0.5, 0}) || !slices.Equal(response.ColumnStrategy, []float64{0.5, 0.5}) {
+		t.Errorf("Se esperaba valor 2 con (0.5, 0.5, 0) y (0.5, 0.5): %+v", response)
+	}
+}
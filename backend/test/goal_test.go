@@ -0,0 +1,129 @@
+package test
+
+import (
+	"proyecto/simplex/logic"
+	"proyecto/simplex/models"
+	"testing"
+)
+
+func peso(v float64) *float64 { return &v }
+
+// casoMetas tiene una capacidad dura x1 + x2 <= 8, una meta de ganancia
+// 3x1 + 2x2 >= 24 y una meta de producción x2 >= 6 que no se pueden cumplir juntas
+func casoMetas(method string) models.GoalRequest {
+	return models.GoalRequest{
+		Method: method,
+		Goals: []models.Goal{
+			{Name: "ganancia", Coefficients: []float64{3, 2}, Target: 24, Type: "ge", Priority: 1},
+			{Name: "producción", Coefficients: []float64{0, 1}, Target: 6, Type: "ge", Weight: peso(2), Priority: 2},
+		},
+		Constraints:     [][]float64{{1, 1}},
+		RHS:             []float64{8},
+		ConstraintTypes: []string{"le"},
+	}
+}
+
+// Test: el método ponderado prioriza la meta de mayor peso
+func TestSolveGoals_Ponderado(t *testing.T) {
+	response, err := logic.SolveGoals(casoMetas(""))
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	if response.Method != models.GoalWeighted || len(response.Stages) != 1 || response.Stages[0].Deviation != 6 {
+		t.Fatalf("Se esperaba un solo programa con desviación 6: %+v", response)
+	}
+	if response.Variables["x1"] != 2 || response.Variables["x2"] != 6 {
+		t.Errorf("Se esperaba x = (2, 6), got %v", response.Variables)
+	}
+	if response.Goals[0].Achieved || response.Goals[0].Under != 6 || !response.Goals[1].Achieved || response.Goals[1].Value != 6 {
+		t.Errorf("Cumplimiento incorrecto: %+v", response.Goals)
+	}
+	if len(response.Stages[0].Solution.TableauxHistory) < 2 || response.Stages[0].Model.ConstraintTypes[1] != "le" {
+		t.Errorf("Se esperaban las tablas del simplex y las metas partidas en le/ge: %+v", response.Stages[0].Model)
+	}
+}
+
+// Test: un peso 0 explícito deja la meta sin penalizar (no se toma como 1)
+func TestSolveGoals_PesoCero(t *testing.T) {
+	req := casoMetas("")
+	req.Goals[0].Weight, req.Goals[1].Weight = peso(0), nil
+	response, err := logic.SolveGoals(req)
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	if response.Stages[0].Deviation != 0 || response.Stages[0].Model.Objective[2] != 0 || response.Stages[0].Model.Objective[4] != 1 {
+		t.Fatalf("Se esperaba la ganancia sin penalizar y la producción con peso 1: %+v", response.Stages[0].Model.Objective)
+	}
+	if !response.Goals[1].Achieved {
+		t.Errorf("La meta de producción debería cumplirse: %+v", response.Goals)
+	}
+}
+
+// Test: una meta de igualdad se parte en dos filas dependientes; un pivoteo deja una
+// de ellas en 0 con error de redondeo y el simplex dual no debe tomarla como infactible
+func TestSolveGoals_MetaIgualdad(t *testing.T) {
+	response, err := logic.SolveGoals(models.GoalRequest{
+		Goals: []models.Goal{
+			{Coefficients: []float64{5, 5}, Target: 5, Type: "eq", Weight: peso(3)},
+			{Coefficients: []float64{1, 6}, Target: 12, Type: "le", Weight: peso(4)},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	if response.Status != models.StatusOptimal || response.Stages[0].Deviation != 0 {
+		t.Fatalf("Se esperaba un óptimo sin desviación: %+v", response)
+	}
+	if !response.Goals[0].Achieved || !response.Goals[1].Achieved || response.Goals[0].Value != 5 {
+		t.Errorf("Ambas metas deberían cumplirse: %+v", response.Goals)
+	}
+}
+
+// Test: el método lexicográfico fija la desviación de cada nivel antes del siguiente
+func TestSolveGoals_Lexicografico(t *testing.T) {
+	response, err := logic.SolveGoals(casoMetas(models.GoalLexicographic))
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	if len(response.Stages) != 2 || response.Stages[0].Deviation != 0 || response.Stages[1].Deviation != 12 {
+		t.Fatalf("Se esperaban dos niveles con desviaciones 0 y 12: %+v", response.Stages)
+	}
+	if response.Variables["x1"] != 8 || response.Variables["x2"] != 0 || !response.Goals[0].Achieved || response.Goals[1].Under != 6 {
+		t.Errorf("Se esperaba x = (8, 0) cumpliendo solo la ganancia: %v %+v", response.Variables, response.Goals)
+	}
+	if len(response.Stages[1].Model.Constraints) != len(response.Stages[0].Model.Constraints)+1 {
+		t.Errorf("El segundo nivel debe agregar la restricción del primero")
+	}
+
+	// Restricciones duras incompatibles
+	req := casoMetas(models.GoalLexicographic)
+	req.Constraints = append(req.Constraints, []float64{1, 1})
+	req.RHS, req.ConstraintTypes = append(req.RHS, 10), append(req.ConstraintTypes, "ge")
+	response, err = logic.SolveGoals(req)
+	if err != nil || response.Status != models.StatusInfeasible {
+		t.Errorf("Se esperaba un modelo infactible: %+v %v", response, err)
+	}
+}
+
+// Test: una meta de igualdad de primer nivel queda fija al buscar la del segundo
+func TestSolveGoals_LexicograficoConIgualdad(t *testing.T) {
+	response, err := logic.SolveGoals(models.GoalRequest{
+		Method: models.GoalLexicographic,
+		Goals: []models.Goal{
+			{Name: "total", Coefficients: []float64{1, 1}, Target: 6, Type: "eq", Priority: 1},
+			{Name: "x1", Coefficients: []float64{1, 0}, Target: 8, Type: "ge", Priority: 2},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	if response.Status != models.StatusOptimal || len(response.Stages) != 2 || response.Stages[0].Deviation != 0 || response.Stages[1].Deviation != 2 {
+		t.Fatalf("Se esperaban dos niveles con desviaciones 0 y 2: %+v", response.Stages)
+	}
+	if response.Variables["x1"] != 6 || response.Variables["x2"] != 0 {
+		t.Errorf("Se esperaba x = (6, 0), got %v", response.Variables)
+	}
+	if !response.Goals[0].Achieved || response.Goals[0].Value != 6 || response.Goals[1].Achieved || response.Goals[1].Under != 2 {
+		t.Errorf("Se esperaba cumplir la igualdad y quedar 2 por debajo en x1: %+v", response.Goals)
+	}
+}
@@ -0,0 +1,155 @@
+package test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"proyecto/simplex/handlers"
+	"proyecto/simplex/logic"
+	"proyecto/simplex/models"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Test: las tablas del propio solver aprueban con nota 1
+func TestGradeTableaux_HistorialDelSolver(t *testing.T) {
+	req, result := casoBasico(t)
+
+	response, err := logic.GradeTableaux(models.GradeRequest{SimplexRequest: req, Tableaux: result.TableauxHistory})
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	if !response.Passed || response.Score != 1 || response.FirstError != nil || !response.FinalCorrect {
+		t.Fatalf("Se esperaba aprobado: %+v", response)
+	}
+	if len(response.Steps) != 3 || !response.Steps[1].MatchesRule || response.Steps[1].Pivot.Entering != "x2" {
+		t.Errorf("Pasos incorrectos: %+v", response.Steps)
+	}
+	if response.Message != "Todas las tablas son correctas y la tabla final resuelve el problema." {
+		t.Errorf("Mensaje inesperado: %q", response.Message)
+	}
+}
+
+// Test: el historial del solver aprueba aunque el truncamiento a 2 decimales se
+// acumule en varias iteraciones (primal) o haya RHS negativos (dual)
+func TestGradeTableaux_HistorialTruncado(t *testing.T) {
+	cases := map[string]models.SimplexRequest{
+		"max": {
+			Objective:       []float64{7, 11, 13},
+			Constraints:     [][]float64{{3, 7, 9}, {11, 3, 7}, {5, 13, 3}},
+			RHS:             []float64{170, 230, 310},
+			Type:            "max",
+			ConstraintTypes: []string{"le", "le", "le"},
+		},
+		"min": {
+			Objective:       []float64{2, 3},
+			Constraints:     [][]float64{{1, 1}, {3, 1}},
+			RHS:             []float64{4, 6},
+			Type:            "min",
+			ConstraintTypes: []string{"ge", "ge"},
+		},
+		// el cociente del paso 2 en la tabla truncada (0.5 contra 0.48) no es el exacto
+		"min cocientes": {
+			Objective:       []float64{1, 1, 9},
+			Constraints:     [][]float64{{2, 2, 3}, {6, 0, 0}},
+			RHS:             []float64{11, 12},
+			Type:            "min",
+			ConstraintTypes: []string{"ge", "ge"},
+		},
+	}
+
+	for name, req := range cases {
+		result, err := logic.SolveRequest(req)
+		if err != nil {
+			t.Fatalf("%s: error inesperado: %v", name, err)
+		}
+		response, err := logic.GradeTableaux(models.GradeRequest{SimplexRequest: req, Tableaux: result.TableauxHistory})
+		if err != nil {
+			t.Fatalf("%s: error inesperado: %v", name, err)
+		}
+		if !response.Passed || response.Score != 1 {
+			t.Errorf("%s: se esperaba aprobado: %+v", name, response)
+		}
+	}
+}
+
+// Test: un error de cuentas se marca como primer error con la celda afectada
+func TestGradeTableaux_ErrorAritmetico(t *testing.T) {
+	req, result := casoBasico(t)
+	tableaux := result.TableauxHistory
+	tableaux[1].Matrix[3][6] = 7 // debería ser 6
+
+	response, err := logic.GradeTableaux(models.GradeRequest{SimplexRequest: req, Tableaux: tableaux})
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	step := response.Steps[1]
+	if response.Passed || response.FirstError == nil || *response.FirstError != 1 || !step.FirstError {
+		t.Fatalf("Se esperaba el primer error en la tabla 1: %+v", response)
+	}
+	if !step.PivotValid || step.ArithmeticValid || len(step.Cells) != 1 || step.Cells[0].Header != "RHS" || step.Cells[0].Expected != 6 || step.Cells[0].Got != 7 {
+		t.Errorf("Celdas incorrectas: %+v", step)
+	}
+	if response.Score >= 1 {
+		t.Errorf("La nota debería ser menor a 1: %v", response.Score)
+	}
+}
+
+// Test: un pivote sin el menor cociente es inválido aunque las cuentas estén bien
+func TestGradeTableaux_PivoteInvalido(t *testing.T) {
+	req, result := casoBasico(t)
+	tableaux := []models.TableauStep{
+		result.TableauxHistory[0],
+		{
+			Basis: []string{"Z", "s1", "s2", "x2"},
+			Matrix: models.SimplexTableau{
+				{1, 4.5, 0, 0, 0, 2.5, 45},
+				{0, 1, 0, 1, 0, 0, 4},
+				{0, -3, 0, 0, 1, -1, -6},
+				{0, 1.5, 1, 0, 0, 0.5, 9},
+			},
+		},
+	}
+
+	response, err := logic.GradeTableaux(models.GradeRequest{SimplexRequest: req, Tableaux: tableaux})
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	step := response.Steps[1]
+	if step.PivotValid || !step.ArithmeticValid || step.Pivot.Row != 3 || step.Pivot.Col != 2 {
+		t.Errorf("Se esperaba pivote inválido con cuentas correctas: %+v", step)
+	}
+	if step.Message != "La fila de s3 no tiene el menor cociente (9; el mínimo es 6): la solución dejaría de ser factible." {
+		t.Errorf("Mensaje inesperado: %q", step.Message)
+	}
+	if response.FinalCorrect || response.FinalStatus != models.StatusInfeasible || response.Score != 0.33 {
+		t.Errorf("Verificación final incorrecta: %+v", response)
+	}
+}
+
+// Test: endpoint /api/simplex/grade
+func TestGradeHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.POST("/api/simplex/grade", handlers.GradeHandler)
+
+	req, result := casoBasico(t)
+	body, _ := json.Marshal(models.GradeRequest{SimplexRequest: req, Tableaux: result.TableauxHistory})
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/simplex/grade", bytes.NewBuffer(body)))
+	var response models.GradeResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil || w.Code != http.StatusOK || !response.Passed {
+		t.Errorf("Se esperaba 200 aprobado, got %d: %s", w.Code, w.Body.String())
+	}
+
+	body, _ = json.Marshal(models.GradeRequest{SimplexRequest: req})
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/simplex/grade", bytes.NewBuffer(body)))
+	var errResponse models.ErrorResponse
+	json.Unmarshal(w.Body.Bytes(), &errResponse)
+	if w.Code != http.StatusBadRequest || errResponse.Error.Field != "tableaux" {
+		t.Errorf("Se esperaba 400 en tableaux, got %d: %s", w.Code, w.Body.String())
+	}
+}
@@ -0,0 +1,138 @@
+package test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"proyecto/simplex/handlers"
+	"proyecto/simplex/logic"
+	"proyecto/simplex/models"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Test: región del caso básico, vértice óptimo y recorrido del Simplex
+func TestFeasibleRegion_DosVariables(t *testing.T) {
+	req, _ := casoBasico(t)
+	region, err := logic.FeasibleRegion(req)
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	if len(region.Vertices) != 5 || !region.Bounded || region.Status != models.StatusOptimal || *region.Optimal != 36 {
+		t.Fatalf("Región incorrecta: %+v", region)
+	}
+
+	vertexAt := func(x, y float64) int {
+		return slices.IndexFunc(region.Vertices, func(v models.RegionVertex) bool { return v.Point[0] == x && v.Point[1] == y })
+	}
+	optimal := vertexAt(2, 6)
+	if optimal < 0 || !slices.Equal(region.OptimalVertices, []int{optimal}) {
+		t.Fatalf("Se esperaba el vértice óptimo (2, 6), got %v en %+v", region.OptimalVertices, region.Vertices)
+	}
+	if active := region.Vertices[optimal].Active; !slices.Equal(active, []string{"c2", "c3"}) {
+		t.Errorf("Restricciones activas en (2, 6): got %v", active)
+	}
+
+	want := []int{vertexAt(0, 0), vertexAt(0, 6), optimal}
+	if len(region.Path) != len(want) {
+		t.Fatalf("Se esperaban %d tablas en el recorrido, got %+v", len(want), region.Path)
+	}
+	for k, step := range region.Path {
+		if step.Vertex == nil || *step.Vertex != want[k] {
+			t.Errorf("Tabla %d: se esperaba el vértice %d, got %+v", k, want[k], step)
+		}
+	}
+	if len(region.IsoLines) != 4 || region.IsoLines[3] != 36 {
+		t.Errorf("Rectas de nivel: got %v", region.IsoLines)
+	}
+}
+
+// Test: en 3 variables el cubo unitario tiene 8 vértices y 6 caras de 4 vértices
+func TestFeasibleRegion_TresVariables(t *testing.T) {
+	region, err := logic.FeasibleRegion(models.SimplexRequest{
+		Objective:       []float64{1, 1, 1},
+		Constraints:     [][]float64{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}},
+		RHS:             []float64{1, 1, 1},
+		Type:            "max",
+		ConstraintTypes: []string{"le", "le", "le"},
+	})
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	if len(region.Vertices) != 8 || len(region.Faces) != 6 || *region.Optimal != 3 {
+		t.Fatalf("Poliedro incorrecto: %d vértices, %d caras", len(region.Vertices), len(region.Faces))
+	}
+	for _, face := range region.Faces {
+		if len(face.Vertices) != 4 {
+			t.Errorf("La cara %s debería tener 4 vértices: %v", face.Constraint, face.Vertices)
+		}
+	}
+	if p := region.Vertices[region.OptimalVertices[0]].Point; !slices.Equal(p, []float64{1, 1, 1}) {
+		t.Errorf("Se esperaba el óptimo en (1, 1, 1), got %v", p)
+	}
+}
+
+// Test: la región no acotada se recorta con la vista
+func TestFeasibleRegion_NoAcotada(t *testing.T) {
+	region, err := logic.FeasibleRegion(models.SimplexRequest{
+		Objective:       []float64{1, 1},
+		Constraints:     [][]float64{{1, -1}},
+		RHS:             []float64{1},
+		Type:            "max",
+		ConstraintTypes: []string{"le"},
+	})
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	if region.Bounded || region.Status != models.StatusUnbounded || region.Optimal != nil {
+		t.Fatalf("Se esperaba una región no acotada: %+v", region)
+	}
+	if !slices.ContainsFunc(region.Vertices, func(v models.RegionVertex) bool { return v.Clipped }) {
+		t.Errorf("Se esperaban vértices del recorte: %+v", region.Vertices)
+	}
+}
+
+// Test: endpoint del método gráfico (SVG en 2 variables y errores de dimensión)
+func TestGraphHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.POST("/api/simplex/graph", handlers.GraphHandler)
+
+	post := func(path, body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, path, bytes.NewBufferString(body)))
+		return w
+	}
+
+	w := post("/api/simplex/graph?format=svg", `{"objective":[3,5],"constraints":[[1,0],[0,2],[3,2]],"rhs":[4,12,18],"type":"max","constraint_types":["le","le","le"]}`)
+	if w.Code != http.StatusOK || w.Header().Get("Content-Type") != "image/svg+xml" {
+		t.Fatalf("Se esperaba un SVG, got %d %s", w.Code, w.Header().Get("Content-Type"))
+	}
+	if body := w.Body.String(); !strings.HasPrefix(body, "<svg") || !strings.Contains(body, "<polygon") || !strings.Contains(body, "Z* = 36 (2, 6)") {
+		t.Errorf("SVG incompleto: %s", body)
+	}
+
+	cube := `{"objective":[1,1,1],"constraints":[[1,1,1]],"rhs":[1],"type":"max","constraint_types":["le"]}`
+	if w := post("/api/simplex/graph", cube); w.Code != http.StatusOK {
+		t.Errorf("Se esperaba 200 en 3 variables, got %d: %s", w.Code, w.Body.String())
+	}
+
+	errorCases := []struct {
+		path, body, code string
+	}{
+		{"/api/simplex/graph?format=svg", cube, "graph_svg_dimensions"},
+		{"/api/simplex/graph", `{"objective":[1,1,1,1],"constraints":[[1,1,1,1]],"rhs":[1],"type":"max","constraint_types":["le"]}`, "graph_dimensions"},
+	}
+	for _, tc := range errorCases {
+		w := post(tc.path, tc.body)
+		var response models.ErrorResponse
+		json.Unmarshal(w.Body.Bytes(), &response)
+		if w.Code != http.StatusUnprocessableEntity || response.Error.Code != tc.code {
+			t.Errorf("Se esperaba 422 %s, got %d: %s", tc.code, w.Code, w.Body.String())
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"proyecto/simplex/handlers"
+	"proyecto/simplex/models"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+// handlerCase es un caso del test de los endpoints de los solvers especializados:
+// un modelo válido con la comprobación de su respuesta y un cuerpo inválido con la
+// cantidad de errores de validación esperados
+type handlerCase struct {
+	name    string
+	path    string
+	handler gin.HandlerFunc
+	model   any
+	check   func(body []byte) bool
+	invalid string
+	errors  int
+}
+
+// decodeAs decodifica la respuesta de un endpoint
+func decodeAs[T any](body []byte) T {
+	var response T
+	json.Unmarshal(body, &response)
+	return response
+}
+
+// Test: cada endpoint responde 200 con la solución del modelo válido y 400
+// invalid_model con todos los errores del cuerpo inválido
+func TestSolverHandlers(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	cases := []handlerCase{
+		{
+			name:    "transporte",
+			path:    "/api/transportation",
+			handler: handlers.TransportationHandler,
+			model:   casoTransporte(models.TransportVogel),
+			check: func(body []byte) bool {
+				r := decodeAs[models.TransportationResponse](body)
+				return r.TotalCost == 435 && len(r.Initial) > 0 && r.Initial[0].RowPenalties != nil
+			},
+			// oferta negativa, filas de costos y método
+			invalid: `{"supply":[10,-5],"demand":[5],"costs":[[1]],"method":"simplex"}`,
+			errors:  3,
+		},
+		{
+			name:    "asignación",
+			path:    "/api/assignment",
+			handler: handlers.AssignmentHandler,
+			model:   casoAsignacion(),
+			check: func(body []byte) bool {
+				r := decodeAs[models.AssignmentResponse](body)
+				return r.Total == 140 && len(r.Steps) > 0 && len(r.Steps[0].Matrix) == 4
+			},
+			// fila de costos, tipo y celda prohibida
+			invalid: `{"costs":[[1,2],[3]],"type":"maximo","forbidden":[{"row":5,"col":0}]}`,
+			errors:  3,
+		},
+		{
+			name:    "redes",
+			path:    "/api/network",
+			handler: handlers.NetworkHandler,
+			model:   casoRed(),
+			check: func(body []byte) bool {
+				r := decodeAs[models.NetworkResponse](body)
+				return r.TotalCost == 14 && len(r.Iterations) >= 2
+			},
+			// nodo desconocido, cotas y balance
+			invalid: `{"nodes":[{"name":"s","supply":3},{"name":"t","supply":-2}],"arcs":[{"from":"s","to":"x"},{"from":"s","to":"t","lower":2,"upper":1}]}`,
+			errors:  3,
+		},
+		{
+			name:    "juegos",
+			path:    "/api/game",
+			handler: handlers.GameHandler,
+			model: models.GameRequest{
+				Payoffs:  [][]float64{{3, -1, 5}, {-2, 4, 6}},
+				RowNames: []string{"Alta", "Baja"},
+				Language: "en",
+			},
+			check: func(body []byte) bool {
+				r := decodeAs[models.GameResponse](body)
+				return r.Status == models.StatusOptimal && r.Value == 1 && len(r.Rows) > 0 && r.Rows[0] == "Alta"
+			},
+			// dos filas con distinta cantidad de columnas
+			invalid: `{"payoffs":[[1,2],[3],[4,5,6]]}`,
+			errors:  2,
+		},
+		{
+			name:    "metas",
+			path:    "/api/goal",
+			handler: handlers.GoalHandler,
+			model:   casoMetas(models.GoalLexicographic),
+			check: func(body []byte) bool {
+				r := decodeAs[models.GoalResponse](body)
+				return r.Status == models.StatusOptimal && len(r.Goals) == 2
+			},
+			// tipo, coeficientes, peso y método
+			invalid: `{"goals":[{"coefficients":[1,2],"target":5,"type":"gt"},{"coefficients":[1],"target":1,"type":"le","weight":-1}],"method":"pareto"}`,
+			errors:  4,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := gin.New()
+			r.POST(tc.path, tc.handler)
+
+			body, _ := json.Marshal(tc.model)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, tc.path, bytes.NewBuffer(body)))
+			if w.Code != http.StatusOK || !tc.check(w.Body.Bytes()) {
+				t.Errorf("Respuesta inesperada, got %d: %s", w.Code, w.Body.String())
+			}
+
+			w = httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, tc.path, bytes.NewBufferString(tc.invalid)))
+			errResponse := decodeAs[models.ErrorResponse](w.Body.Bytes())
+			if w.Code != http.StatusBadRequest || errResponse.Error.Code != "invalid_model" {
+				t.Fatalf("Se esperaba 400 invalid_model, got %d: %s", w.Code, w.Body.String())
+			}
+			if errs, _ := errResponse.Error.Details["errors"].([]any); len(errs) != tc.errors {
+				t.Errorf("Se esperaban %d errores, got %v", tc.errors, errResponse.Error.Details)
+			}
+		})
+	}
+}
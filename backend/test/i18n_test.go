@@ -0,0 +1,85 @@
+package test
+
+import (
+	"errors"
+	"proyecto/simplex/i18n"
+	"proyecto/simplex/logic"
+	"proyecto/simplex/models"
+	"testing"
+)
+
+// Test: se elige el idioma soportado que mejor coincide con Accept-Language
+func TestFromAcceptLanguage(t *testing.T) {
+	cases := map[string]string{
+		"":                        "es",
+		"en-US,en;q=0.9":          "en",
+		"es-AR,es;q=0.9,en;q=0.8": "es",
+		"fr-FR,en;q=0.5":          "en",
+		"de":                      "es",
+		"no es un encabezado;;;":  "es",
+	}
+	for header, want := range cases {
+		if got := i18n.FromAcceptLanguage(header); got != want {
+			t.Errorf("FromAcceptLanguage(%q) = %q, want %q", header, got, want)
+		}
+	}
+}
+
+// Test: los errores de validación tienen código y se traducen al mostrarlos
+func TestErroresTraducidos(t *testing.T) {
+	err := logic.ValidarEntrada([]float64{1, 2}, [][]float64{{1, 1}}, []float64{1, 2})
+	if err == nil {
+		t.Fatal("Esperaba error por dimensiones inconsistentes en rhs")
+	}
+	if !errors.Is(err, i18n.New(i18n.RHSLength)) {
+		t.Errorf("Código de error incorrecto: %v", err)
+	}
+	if got := i18n.Localize(err, "en"); got != "the length of rhs does not match the number of rows of constraints" {
+		t.Errorf("Traducción incorrecta, got: %q", got)
+	}
+	if got := err.Error(); got != "la longitud de rhs no coincide con el número de filas de constraints" {
+		t.Errorf("El mensaje por defecto debería ser en español, got: %q", got)
+	}
+
+	_, _, err = logic.StandardizeConstraints([][]float64{{1}}, []float64{1}, []string{"eq"})
+	if !errors.Is(err, logic.ErrEqualityUnsupported) {
+		t.Errorf("Se esperaba ErrEqualityUnsupported, got: %v", err)
+	}
+}
+
+// Test: la respuesta describe el estado en el idioma del modelo
+func TestSolveRequest_MensajeDeEstado(t *testing.T) {
+	req := models.SimplexRequest{
+		Objective:       []float64{1, 1},
+		Constraints:     [][]float64{{-1, 1}},
+		RHS:             []float64{1},
+		Type:            "max",
+		ConstraintTypes: []string{"le"},
+		Language:        "en",
+	}
+	result, err := logic.SolveRequest(req)
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	if result.Status != "unbounded" {
+		t.Fatalf("Se esperaba unbounded, got: %v", result.Status)
+	}
+	if result.Message != "The problem is unbounded: the objective function can improve indefinitely" {
+		t.Errorf("Mensaje incorrecto, got: %q", result.Message)
+	}
+
+	// Función objetivo constante: el mensaje sale de la bandera, no del texto v1
+	req.Objective = []float64{0, 0}
+	result, _ = logic.SolveRequest(req)
+	if !result.ConstantObjective || result.Status != "optimal (degenerate: multiple solutions)" {
+		t.Errorf("Se esperaba el óptimo de una función constante, got: %q", result.Status)
+	}
+	if result.Message != "The objective function is constant: every feasible solution is optimal" {
+		t.Errorf("Mensaje incorrecto, got: %q", result.Message)
+	}
+
+	req.Type = "maximo"
+	if _, err := logic.SolveRequest(req); !errors.Is(err, logic.ErrInvalidType) {
+		t.Errorf("Se esperaba ErrInvalidType, got: %v", err)
+	}
+}
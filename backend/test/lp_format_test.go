@@ -0,0 +1,102 @@
+package test
+
+import (
+	"bytes"
+	"errors"
+	"proyecto/simplex/formats"
+	"reflect"
+	"testing"
+)
+
+// Modelo en formato CPLEX LP con todas las secciones soportadas
+const lpModelo = `\ Modelo de prueba
+Maximize
+ obj: 3 x + 5 y + 2 z
+Subject To
+ c1: x + z <= 4
+ c2: 2 y >= 1
+ c3: 3 x + 2 y
+     - z = 18
+Bounds
+ 0 <= x <= 10
+ y free
+ z <= 7
+General
+ x
+End
+`
+
+// Test: lectura del formato LP
+func TestParseLP(t *testing.T) {
+	req, err := formats.ParseLP(lpModelo)
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+
+	if req.Type != "max" || !reflect.DeepEqual(req.Objective, []float64{3, 5, 2}) {
+		t.Errorf("Objetivo incorrecto, got: %v %v", req.Type, req.Objective)
+	}
+	want := [][]float64{{1, 0, 1}, {0, 2, 0}, {3, 2, -1}}
+	if !reflect.DeepEqual(req.Constraints, want) {
+		t.Errorf("Restricciones incorrectas, got: %v", req.Constraints)
+	}
+	if !reflect.DeepEqual(req.ConstraintTypes, []string{"le", "ge", "eq"}) || !reflect.DeepEqual(req.RHS, []float64{4, 1, 18}) {
+		t.Errorf("Tipos o RHS incorrectos, got: %v %v", req.ConstraintTypes, req.RHS)
+	}
+	if *req.Bounds[0].Upper != 10 || !req.Bounds[1].Free || *req.Bounds[2].Upper != 7 {
+		t.Errorf("Cotas incorrectas, got: %+v", req.Bounds)
+	}
+	if !reflect.DeepEqual(req.Integer, []bool{true, false, false}) {
+		t.Errorf("Enteras incorrectas, got: %v", req.Integer)
+	}
+}
+
+// Test: una variable desconocida en Binary informa su posición
+func TestParseLP_VariableDesconocida(t *testing.T) {
+	_, err := formats.ParseLP("Maximize\n obj: x\nSubject To\n x <= 4\nBinary\n w\nEnd\n")
+	var syntaxErr *formats.SyntaxError
+	if !errors.As(err, &syntaxErr) || syntaxErr.Line != 6 || syntaxErr.Column != 2 {
+		t.Errorf("Se esperaba error en 6:2, got: %v", err)
+	}
+}
+
+// Test: escribir y volver a leer en formato LP conserva el modelo
+func TestWriteLP_IdaYVuelta(t *testing.T) {
+	original, err := formats.ParseAlgebraic("min 60a + 80b\nst\n6a + 5b >= 50\n2a + 5b >= 30\nbounds\nb <= 8\nint a")
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := formats.WriteLP(&buf, original, "TEST"); err != nil {
+		t.Fatalf("Error al escribir: %v", err)
+	}
+	parsed, err := formats.ParseLP(buf.String())
+	if err != nil {
+		t.Fatalf("Error al releer: %v\n%s", err, buf.String())
+	}
+	if !reflect.DeepEqual(parsed, original) {
+		t.Errorf("El modelo cambió:\n got: %+v\nwant: %+v", parsed, original)
+	}
+}
+
+// Test: una restricción con todos los coeficientes en cero se escribe como "0 x"
+// para que ParseLP la pueda releer
+func TestWriteLP_FilaEnCero(t *testing.T) {
+	original, err := formats.ParseLP("Maximize\n obj: 3 x + 5 y\nSubject To\n c1: x + y <= 4\n c2: 0 x <= 5\nEnd\n")
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := formats.WriteLP(&buf, original, "TEST"); err != nil {
+		t.Fatalf("Error al escribir: %v", err)
+	}
+	parsed, err := formats.ParseLP(buf.String())
+	if err != nil {
+		t.Fatalf("Error al releer: %v\n%s", err, buf.String())
+	}
+	if !reflect.DeepEqual(parsed, original) {
+		t.Errorf("El modelo cambió:\n got: %+v\nwant: %+v", parsed, original)
+	}
+}
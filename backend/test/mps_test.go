@@ -0,0 +1,122 @@
+package test
+
+import (
+	"bytes"
+	"math"
+	"proyecto/simplex/formats"
+	"proyecto/simplex/logic"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// Modelo del caso básico en MPS fijo (columnas 2-3, 5-12, 15-22, 25-36)
+const mpsCasoBasico = `NAME          BASICO
+* Z = 3x1 + 5x2
+OBJSENSE
+    MAX
+ROWS
+ N  COST
+ L  LIM1
+ L  LIM2
+ L  LIM3
+COLUMNS
+    X1        COST      3              LIM1      1
+    X1        LIM3      3
+    X2        COST      5              LIM2      2
+    X2        LIM3      2
+RHS
+    RHS       LIM1      4              LIM2      12
+    RHS       LIM3      18
+ENDATA
+`
+
+// Test: lectura de MPS fijo y resolución
+func TestParseMPS_Fijo(t *testing.T) {
+	req, err := formats.ParseMPS(strings.NewReader(mpsCasoBasico), true)
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+
+	if req.Type != "max" {
+		t.Errorf("Tipo incorrecto, got: %v", req.Type)
+	}
+	if !reflect.DeepEqual(req.Constraints, [][]float64{{1, 0}, {0, 2}, {3, 2}}) {
+		t.Errorf("Restricciones incorrectas, got: %v", req.Constraints)
+	}
+	if !reflect.DeepEqual(req.ConstraintNames, []string{"LIM1", "LIM2", "LIM3"}) {
+		t.Errorf("Nombres incorrectos, got: %v", req.ConstraintNames)
+	}
+
+	result, err := logic.SolveRequest(req)
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	if math.Abs(result.Optimal-36.0) > 1e-6 || math.Abs(result.Variables["X2"]-6.0) > 1e-6 {
+		t.Errorf("Resultado incorrecto, got: %v %v", result.Optimal, result.Variables)
+	}
+}
+
+// Test: MPS libre con RANGES, BOUNDS y marcadores de enteras
+func TestParseMPS_Libre(t *testing.T) {
+	src := `NAME TEST
+ROWS
+ N obj
+ G r1
+ E r2
+COLUMNS
+ MARKER 'MARKER' 'INTORG'
+ a obj 1 r1 1
+ a r2 1
+ MARKER 'MARKER' 'INTEND'
+ b obj 2 r1 1
+RHS
+ rhs r1 2 r2 1
+RANGES
+ rng r1 3
+BOUNDS
+ UP bnd b 4
+ LO bnd a 0.5
+ENDATA
+`
+	req, err := formats.ParseMPS(strings.NewReader(src), false)
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+
+	if req.Type != "min" {
+		t.Errorf("Tipo incorrecto, got: %v", req.Type)
+	}
+	// r1 con rango 3 se convierte en 2 <= a + b <= 5
+	if !reflect.DeepEqual(req.ConstraintTypes, []string{"ge", "le", "eq"}) || !reflect.DeepEqual(req.RHS, []float64{2, 5, 1}) {
+		t.Errorf("Filas incorrectas, got: %v %v", req.ConstraintTypes, req.RHS)
+	}
+	if !reflect.DeepEqual(req.Integer, []bool{true, false}) {
+		t.Errorf("Enteras incorrectas, got: %v", req.Integer)
+	}
+	if *req.Bounds[0].Lower != 0.5 || *req.Bounds[1].Upper != 4 {
+		t.Errorf("Cotas incorrectas, got: %+v", req.Bounds)
+	}
+}
+
+// Test: escribir y volver a leer conserva el modelo
+func TestWriteMPS_IdaYVuelta(t *testing.T) {
+	original, err := formats.ParseMPS(strings.NewReader(mpsCasoBasico), true)
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+
+	for _, fixed := range []bool{true, false} {
+		var buf bytes.Buffer
+		if err := formats.WriteMPS(&buf, original, "BASICO", fixed); err != nil {
+			t.Fatalf("Error al escribir (fixed=%v): %v", fixed, err)
+		}
+		parsed, err := formats.ParseMPS(&buf, fixed)
+		if err != nil {
+			t.Fatalf("Error al releer (fixed=%v): %v", fixed, err)
+		}
+		if !reflect.DeepEqual(parsed, original) {
+			t.Errorf("El modelo cambió (fixed=%v):\n got: %+v\nwant: %+v", fixed, parsed, original)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package test
+
+import (
+	"proyecto/simplex/logic"
+	"proyecto/simplex/models"
+	"slices"
+	"testing"
+)
+
+func capacidad(v float64) *float64 { return &v }
+
+// casoRed es una red de 4 nodos que envía 4 unidades de s a t (costo mínimo 14)
+func casoRed() models.NetworkRequest {
+	return models.NetworkRequest{
+		Nodes: []models.NetworkNode{{Name: "s", Supply: 4}, {Name: "a"}, {Name: "b"}, {Name: "t", Supply: -4}},
+		Arcs: []models.NetworkArc{
+			{From: "s", To: "a", Cost: 2, Upper: capacidad(4)},
+			{From: "s", To: "b", Cost: 2, Upper: capacidad(2)},
+			{From: "a", To: "b", Cost: 1, Upper: capacidad(2)},
+			{From: "a", To: "t", Cost: 3, Upper: capacidad(3)},
+			{From: "b", To: "t", Cost: 1, Upper: capacidad(5)},
+		},
+	}
+}
+
+// Test: flujo de costo mínimo con capacidades y cota inferior
+func TestSolveNetwork_CostoMinimo(t *testing.T) {
+	response, err := logic.SolveNetwork(casoRed())
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	if response.Status != models.StatusOptimal || response.TotalCost != 14 {
+		t.Fatalf("Se esperaba el óptimo 14, got %v %v: %s", response.Status, response.TotalCost, response.Message)
+	}
+	flows := []float64{2, 2, 2, 0, 4}
+	for k, flow := range flows {
+		if response.Arcs[k].Flow != flow {
+			t.Errorf("Flujo del arco %d: se esperaba %v, got %v", k, flow, response.Arcs[k].Flow)
+		}
+	}
+	// Los potenciales son los duales: en los arcos del árbol el costo reducido es 0
+	if len(response.Potentials) != 4 || response.Potentials[0] != 0 || len(response.Nodes) != 5 {
+		t.Errorf("Potenciales incorrectos: %v", response.Potentials)
+	}
+	for k, step := range response.Iterations[:len(response.Iterations)-1] {
+		if step.Entering == nil || step.Leaving == nil || !slices.ContainsFunc(step.Cycle, func(c models.NetworkCycleArc) bool { return c.Arc == *step.Entering }) {
+			t.Errorf("Iteración %d sin decisión válida: %+v", k, step)
+		}
+	}
+
+	req := casoRed()
+	req.Arcs[3].Lower = 1
+	response, err = logic.SolveNetwork(req)
+	if err != nil || response.TotalCost != 15 || response.Arcs[3].Flow != 1 {
+		t.Errorf("Con lower 1 en a→t se esperaba el costo 15: %+v %v", response, err)
+	}
+}
+
+// Test: flujo máximo y camino más corto como casos particulares
+func TestSolveNetwork_CasosParticulares(t *testing.T) {
+	req := casoRed()
+	req.Problem, req.Source, req.Sink = models.NetworkMaxFlow, "s", "t"
+	req.Arcs[2].Upper, req.Arcs[3].Upper = capacidad(1), capacidad(2)
+	response, err := logic.SolveNetwork(req)
+	if err != nil || response.Status != models.StatusOptimal || response.FlowValue != 5 {
+		t.Fatalf("Se esperaba el flujo máximo 5: %+v %v", response, err)
+	}
+	if last := response.Arcs[len(req.Arcs)]; last.Kind != models.ArcReturn || last.From != "t" || last.To != "s" {
+		t.Errorf("Falta el arco de retorno: %+v", last)
+	}
+
+	req = casoRed()
+	req.Problem, req.Source, req.Sink = models.NetworkShortestPath, "s", "t"
+	req.Arcs[0].Cost, req.Arcs[1].Cost, req.Arcs[2].Cost, req.Arcs[3].Cost = 1, 4, 2, 5
+	response, err = logic.SolveNetwork(req)
+	if err != nil || response.TotalCost != 4 || !slices.Equal(response.Path, []string{"s", "a", "b", "t"}) {
+		t.Errorf("Se esperaba el camino s → a → b → t de longitud 4: %+v %v", response, err)
+	}
+
+	// Sin camino de t a s y con un ciclo negativo
+	req.Source, req.Sink = "t", "s"
+	response, err = logic.SolveNetwork(req)
+	if err != nil || response.Status != models.StatusInfeasible {
+		t.Errorf("Se esperaba que no haya camino: %+v %v", response, err)
+	}
+	req.Source, req.Sink = "s", "t"
+	req.Arcs = append(req.Arcs, models.NetworkArc{From: "b", To: "a", Cost: -3})
+	response, err = logic.SolveNetwork(req)
+	if err != nil || response.Status != models.StatusUnbounded {
+		t.Errorf("Se esperaba un ciclo negativo: %+v %v", response, err)
+	}
+}
+
+// Test: si las capacidades que salen de la fuente no alcanzan para su oferta la red es
+// infactible (queda flujo en los arcos artificiales)
+func TestSolveNetwork_CapacidadInsuficiente(t *testing.T) {
+	req := casoRed()
+	req.Arcs[0].Upper = capacidad(1)
+	response, err := logic.SolveNetwork(req)
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	if response.Status != models.StatusInfeasible {
+		t.Errorf("Se esperaba una red infactible con 3 unidades de capacidad para 4 de oferta: %+v", response)
+	}
+}
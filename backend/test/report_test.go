@@ -0,0 +1,208 @@
+package test
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/zlib"
+	"io"
+	"proyecto/simplex/logic"
+	"proyecto/simplex/models"
+	"proyecto/simplex/report"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// casoBasico devuelve el modelo del caso básico (Z = 3x1 + 5x2) y su solución
+func casoBasico(t *testing.T) (models.SimplexRequest, models.SimplexResponse) {
+	req := models.SimplexRequest{
+		Objective:       []float64{3, 5},
+		Constraints:     [][]float64{{1, 0}, {0, 2}, {3, 2}},
+		RHS:             []float64{4, 12, 18},
+		Type:            "max",
+		ConstraintTypes: []string{"le", "le", "le"},
+	}
+	result, err := logic.SolveRequest(req)
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	return req, result
+}
+
+// Test: el libro XLSX tiene la hoja de solución y una hoja por iteración
+func TestWriteXLSX(t *testing.T) {
+	req, result := casoBasico(t)
+
+	var buf bytes.Buffer
+	if err := report.WriteXLSX(&buf, req, result); err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+
+	f, err := excelize.OpenReader(&buf)
+	if err != nil {
+		t.Fatalf("XLSX inválido: %v", err)
+	}
+	defer f.Close()
+
+	want := []string{"Solución", "Sensibilidad", "Iteración 0", "Iteración 1", "Iteración 2"}
+	if got := f.GetSheetList(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Hojas incorrectas, got: %v, want: %v", got, want)
+	}
+	if v, _ := f.GetCellValue("Solución", "B3"); v != "36" {
+		t.Errorf("Valor óptimo incorrecto en B3, got: %v", v)
+	}
+	if v, _ := f.GetCellValue("Sensibilidad", "C7"); v != "1.5" {
+		t.Errorf("Precio sombra incorrecto en C7, got: %v", v)
+	}
+	if v, _ := f.GetCellValue("Iteración 0", "B1"); v != "x1" {
+		t.Errorf("Encabezado incorrecto en B1, got: %v", v)
+	}
+}
+
+// Test: el ZIP tiene solucion.csv y un CSV por iteración
+func TestWriteCSVZip(t *testing.T) {
+	req, result := casoBasico(t)
+
+	var buf bytes.Buffer
+	if err := report.WriteCSVZip(&buf, req, result); err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("ZIP inválido: %v", err)
+	}
+	var names []string
+	for _, f := range zr.File {
+		names = append(names, f.Name)
+	}
+	want := []string{"solucion.csv", "sensibilidad.csv", "iteracion_0.csv", "iteracion_1.csv", "iteracion_2.csv"}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("Archivos incorrectos, got: %v, want: %v", names, want)
+	}
+}
+
+// Test: el documento LaTeX marca el pivote y usa fracciones
+func TestWriteLaTeX(t *testing.T) {
+	req, result := casoBasico(t)
+
+	var buf bytes.Buffer
+	if err := report.WriteLaTeX(&buf, req, result, report.LaTeXOptions{Fractions: true}); err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	tex := buf.String()
+
+	for _, want := range []string{
+		`\begin{tabular}{l|rrrrrrr}`,
+		`\rowcolor{leaving}$s_{2}$ & $0$ & $0$ & $\boxed{2}$`,
+		`\cellcolor{entering}$x_{2}$`,
+		`$-\frac{1}{3}$`,
+		`Z^* &= 36`,
+	} {
+		if !strings.Contains(tex, want) {
+			t.Errorf("Falta %q en el documento LaTeX", want)
+		}
+	}
+	if n := strings.Count(tex, `\boxed{`); n != 2 {
+		t.Errorf("Se esperaban 2 pivotes recuadrados, got: %d", n)
+	}
+}
+
+// Test: las fracciones salen de los valores exactos y no de los truncados (4/13 se
+// muestra como 0.3 en las tablas, que como fracción sería 3/10)
+func TestWriteLaTeX_FraccionesExactas(t *testing.T) {
+	one, two := 1.0, 2.0
+	for _, tc := range []struct {
+		req  models.SimplexRequest
+		want []string
+	}{
+		{
+			req: models.SimplexRequest{
+				Objective: []float64{1}, Constraints: [][]float64{{13}}, RHS: []float64{4}, Type: "max", ConstraintTypes: []string{"le"},
+			},
+			want: []string{`Z^* &= \frac{4}{13}`, `x_{1} &= \frac{4}{13}`, `$x_{1}$ & $0$ & $1$ & $\frac{1}{13}$ & $\frac{4}{13}$`},
+		},
+		{
+			// x1 entre 1 y 2: el solver usa x1 = x1' + 1 y la solución se lee corrida
+			req: models.SimplexRequest{
+				Objective: []float64{1, 1}, Constraints: [][]float64{{1, 3}}, RHS: []float64{3}, Type: "max", ConstraintTypes: []string{"le"},
+				Bounds: []models.Bound{{Lower: &one, Upper: &two}, {}},
+			},
+			want: []string{`Z^* &= \frac{7}{3}`, `x_{1} &= 2`, `x_{2} &= \frac{1}{3}`},
+		},
+	} {
+		result, err := logic.SolveRequest(tc.req)
+		if err != nil {
+			t.Fatalf("Error inesperado: %v", err)
+		}
+		var buf bytes.Buffer
+		report.WriteLaTeX(&buf, tc.req, result, report.LaTeXOptions{Fractions: true})
+		tex := buf.String()
+		for _, want := range tc.want {
+			if !strings.Contains(tex, want) {
+				t.Errorf("Falta %q en el documento LaTeX:\n%s", want, tex)
+			}
+		}
+		if strings.Contains(tex, `\frac{3}{10}`) {
+			t.Errorf("La fracción se armó desde el valor truncado:\n%s", tex)
+		}
+	}
+}
+
+// Test: el reporte PDF se genera y lleva la cabecera de PDF
+func TestWritePDF(t *testing.T) {
+	req, result := casoBasico(t)
+	if result.Method != "primal" {
+		t.Errorf("Método incorrecto, got: %v", result.Method)
+	}
+
+	var buf bytes.Buffer
+	if err := report.WritePDF(&buf, req, result); err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	if !bytes.HasPrefix(buf.Bytes(), []byte("%PDF-")) {
+		t.Errorf("La salida no es un PDF")
+	}
+	text := pdfText(t, buf.Bytes())
+	for _, want := range []string{"x1, x2 >= 0", "sensibilidad", "Precio sombra", "7.5"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("El PDF no contiene %q", want)
+		}
+	}
+
+	// Las cotas del modelo reemplazan a la no negatividad
+	lower, upper := 1.0, 8.0
+	req.Bounds = []models.Bound{{Lower: &lower}, {Upper: &upper}}
+	result, err := logic.SolveRequest(req)
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	buf.Reset()
+	if err := report.WritePDF(&buf, req, result); err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	if text := pdfText(t, buf.Bytes()); !strings.Contains(text, "x1 >= 1, 0 <= x2 <= 8") || !strings.Contains(text, "ub_x2") {
+		t.Errorf("El PDF no muestra las cotas del modelo: %s", text)
+	}
+}
+
+// pdfText devuelve los textos (operadores Tj) de los flujos comprimidos del PDF, en
+// cp1252 (los textos en ASCII se pueden comparar directamente)
+func pdfText(t *testing.T, pdf []byte) string {
+	var sb strings.Builder
+	for _, m := range regexp.MustCompile(`(?s)stream\r?\n(.*?)endstream`).FindAllSubmatch(pdf, -1) {
+		r, err := zlib.NewReader(bytes.NewReader(m[1]))
+		if err != nil {
+			t.Fatalf("Flujo inválido: %v", err)
+		}
+		content, _ := io.ReadAll(r)
+		for _, tj := range regexp.MustCompile(`\((.*?)\)\s*Tj`).FindAllSubmatch(content, -1) {
+			sb.Write(tj[1])
+			sb.WriteString("\n")
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,68 @@
+package test
+
+import (
+	"proyecto/simplex/logic"
+	"proyecto/simplex/models"
+	"reflect"
+	"testing"
+)
+
+func rango(v float64) *float64 { return &v }
+
+// Test: sensibilidad del caso básico (maximización, simplex primal)
+func TestSensibilidad_Max(t *testing.T) {
+	_, result := casoBasico(t)
+	if result.Sensitivity == nil {
+		t.Fatal("Se esperaba el análisis de sensibilidad")
+	}
+
+	wantVars := []models.VariableSensitivity{
+		{Name: "x1", Value: 2, Coefficient: 3, Lower: rango(0), Upper: rango(7.5)},
+		{Name: "x2", Value: 6, Coefficient: 5, Lower: rango(2)},
+	}
+	if !reflect.DeepEqual(result.Sensitivity.Variables, wantVars) {
+		t.Errorf("Variables incorrectas, got: %+v", result.Sensitivity.Variables)
+	}
+	wantRows := []models.ConstraintSensitivity{
+		{Name: "c1", Type: "le", Slack: 2, RHS: 4, Lower: rango(2)},
+		{Name: "c2", Type: "le", ShadowPrice: 1.5, RHS: 12, Lower: rango(6), Upper: rango(18)},
+		{Name: "c3", Type: "le", ShadowPrice: 1, RHS: 18, Lower: rango(12), Upper: rango(24)},
+	}
+	if !reflect.DeepEqual(result.Sensitivity.Constraints, wantRows) {
+		t.Errorf("Restricciones incorrectas, got: %+v", result.Sensitivity.Constraints)
+	}
+}
+
+// Test: sensibilidad de una minimización con restricciones >= (simplex dual): los
+// precios sombra y los rangos se informan respecto de la restricción original
+func TestSensibilidad_Min(t *testing.T) {
+	result, err := logic.SolveRequest(models.SimplexRequest{
+		Objective:       []float64{2, 3},
+		Constraints:     [][]float64{{1, 1}, {3, 1}},
+		RHS:             []float64{4, 6},
+		Type:            "min",
+		ConstraintTypes: []string{"ge", "ge"},
+		VariableNames:   []string{"x", "y"},
+	})
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	if result.Sensitivity == nil {
+		t.Fatal("Se esperaba el análisis de sensibilidad")
+	}
+
+	wantVars := []models.VariableSensitivity{
+		{Name: "x", Value: 4, Coefficient: 2, Lower: rango(0), Upper: rango(3)},
+		{Name: "y", ReducedCost: 1, Coefficient: 3, Lower: rango(2)},
+	}
+	if !reflect.DeepEqual(result.Sensitivity.Variables, wantVars) {
+		t.Errorf("Variables incorrectas, got: %+v", result.Sensitivity.Variables)
+	}
+	wantRows := []models.ConstraintSensitivity{
+		{Name: "c1", Type: "ge", ShadowPrice: 2, RHS: 4, Lower: rango(2)},
+		{Name: "c2", Type: "ge", Slack: 6, RHS: 6, Upper: rango(12)},
+	}
+	if !reflect.DeepEqual(result.Sensitivity.Constraints, wantRows) {
+		t.Errorf("Restricciones incorrectas, got: %+v", result.Sensitivity.Constraints)
+	}
+}
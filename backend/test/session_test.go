@@ -0,0 +1,172 @@
+package test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"proyecto/simplex/handlers"
+	"proyecto/simplex/logic"
+	"proyecto/simplex/models"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Test: el usuario resuelve el caso básico eligiendo los pivotes del solver
+func TestPivotSession_ReglaDelSolver(t *testing.T) {
+	req, _ := casoBasico(t)
+	session, err := logic.NewPivotSession(req)
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	state := session.State()
+	if state.Method != "primal" || state.Finished || state.CanUndo || state.Iteration != 0 {
+		t.Fatalf("Estado inicial incorrecto: %+v", state)
+	}
+
+	state = session.Pivot(2, 2)
+	if !state.Feedback.Valid || !state.Feedback.MatchesRule || state.Iteration != 1 || state.Tableau.Basis[2] != "x2" {
+		t.Fatalf("Se esperaba pivote correcto: %+v", state.Feedback)
+	}
+	state = session.Pivot(3, 1)
+	if !state.Finished || state.Status != models.StatusOptimal || *state.Optimal != 36 || state.Variables["x1"] != 2 || state.Variables["x2"] != 6 {
+		t.Fatalf("Se esperaba el óptimo: %+v", state)
+	}
+
+	state = session.Pivot(1, 4)
+	if state.Feedback.Valid || state.Iteration != 2 || state.Feedback.Message != "La tabla ya es final: no quedan pivotes por hacer." {
+		t.Errorf("No se esperaban pivotes después del óptimo: %+v", state.Feedback)
+	}
+}
+
+// Test: pivotes inválidos, válidos distintos de la regla y deshacer
+func TestPivotSession_ValidacionYDeshacer(t *testing.T) {
+	req, _ := casoBasico(t)
+	session, err := logic.NewPivotSession(req)
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+
+	invalid := []struct {
+		row, col int
+		message  string
+	}{
+		{3, 2, "La fila de s3 no tiene el menor cociente (9; el mínimo es 6): la solución dejaría de ser factible."},
+		{2, 3, "s1 ya es básica: no puede entrar a la base."},
+		{4, 1, "La fila 4 o la columna 1 no son un pivote posible de la tabla."},
+		{2, 1, "El elemento pivote (0) debe ser positivo."},
+	}
+	for _, tc := range invalid {
+		state := session.Pivot(tc.row, tc.col)
+		if state.Feedback.Valid || state.Iteration != 0 || state.Feedback.Message != tc.message {
+			t.Errorf("Pivote (%d, %d): se esperaba inválido, got %+v", tc.row, tc.col, state.Feedback)
+		}
+	}
+
+	state := session.Pivot(1, 1)
+	if !state.Feedback.Valid || state.Feedback.MatchesRule || state.Iteration != 1 {
+		t.Fatalf("Se esperaba pivote válido distinto de la regla: %+v", state.Feedback)
+	}
+	if s := state.Feedback.Suggested; s.Row != 2 || s.Col != 2 || s.Entering != "x2" || s.Leaving != "s2" {
+		t.Errorf("Pivote sugerido incorrecto: %+v", s)
+	}
+
+	if err := session.Undo(); err != nil {
+		t.Fatalf("Error inesperado al deshacer: %v", err)
+	}
+	if state := session.State(); state.Iteration != 0 || state.CanUndo || state.Tableau.Basis[1] != "s1" {
+		t.Errorf("Deshacer no volvió a la tabla inicial: %+v", state)
+	}
+	if err := session.Undo(); !errors.Is(err, logic.ErrNothingToUndo) {
+		t.Errorf("Se esperaba ErrNothingToUndo, got %v", err)
+	}
+}
+
+// Test: endpoints de las sesiones
+func TestSessionHandlers(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.POST("/api/simplex/sessions", handlers.CreateSessionHandler)
+	r.GET("/api/simplex/sessions/:id", handlers.GetSessionHandler)
+	r.POST("/api/simplex/sessions/:id/pivot", handlers.PivotSessionHandler)
+	r.POST("/api/simplex/sessions/:id/undo", handlers.UndoSessionHandler)
+	r.DELETE("/api/simplex/sessions/:id", handlers.DeleteSessionHandler)
+
+	send := func(method, path, body string) (int, models.SessionResponse) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(method, path, strings.NewReader(body)))
+		var response models.SessionResponse
+		json.Unmarshal(w.Body.Bytes(), &response)
+		return w.Code, response
+	}
+
+	code, created := send(http.MethodPost, "/api/simplex/sessions",
+		`{"objective":[3,5],"constraints":[[1,0],[0,2],[3,2]],"rhs":[4,12,18],"type":"max","constraint_types":["le","le","le"]}`)
+	if code != http.StatusCreated || created.ID == "" {
+		t.Fatalf("Se esperaba 201 con id, got %d: %+v", code, created)
+	}
+	path := "/api/simplex/sessions/" + created.ID
+
+	if code, state := send(http.MethodPost, path+"/pivot", `{"row":2,"col":2}`); code != http.StatusOK || !state.Feedback.Valid || state.Iteration != 1 {
+		t.Errorf("Pivote: got %d %+v", code, state)
+	}
+	if code, state := send(http.MethodPost, path+"/undo", ""); code != http.StatusOK || state.Iteration != 0 || state.ID != created.ID {
+		t.Errorf("Deshacer: got %d %+v", code, state)
+	}
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, path+"/undo", bytes.NewBufferString("")))
+	var errResponse models.ErrorResponse
+	json.Unmarshal(w.Body.Bytes(), &errResponse)
+	if w.Code != http.StatusConflict || errResponse.Error.Code != "session_nothing_to_undo" {
+		t.Errorf("Se esperaba 409, got %d: %s", w.Code, w.Body.String())
+	}
+
+	if code, _ := send(http.MethodDelete, path, ""); code != http.StatusNoContent {
+		t.Errorf("Se esperaba 204, got %d", code)
+	}
+	if code, _ := send(http.MethodGet, path, ""); code != http.StatusNotFound {
+		t.Errorf("Se esperaba 404 después de borrar, got %d", code)
+	}
+}
+
+// Test: con MaxSessions sesiones en memoria, crear otra descarta la usada hace más
+// tiempo y no las que se siguen usando
+func TestSessionHandlers_Limite(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.POST("/api/simplex/sessions", handlers.CreateSessionHandler)
+	r.GET("/api/simplex/sessions/:id", handlers.GetSessionHandler)
+
+	send := func(method, path string) (int, models.SessionResponse) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(method, path, strings.NewReader(
+			`{"objective":[3,5],"constraints":[[1,0],[0,2],[3,2]],"rhs":[4,12,18],"type":"max","constraint_types":["le","le","le"]}`)))
+		var response models.SessionResponse
+		json.Unmarshal(w.Body.Bytes(), &response)
+		return w.Code, response
+	}
+
+	_, used := send(http.MethodPost, "/api/simplex/sessions")
+	_, idle := send(http.MethodPost, "/api/simplex/sessions")
+	if code, _ := send(http.MethodGet, "/api/simplex/sessions/"+used.ID); code != http.StatusOK {
+		t.Fatalf("Se esperaba 200, got %d", code)
+	}
+	var last models.SessionResponse
+	for range handlers.MaxSessions - 1 {
+		if code, created := send(http.MethodPost, "/api/simplex/sessions"); code == http.StatusCreated {
+			last = created
+		} else {
+			t.Fatalf("Se esperaba 201, got %d", code)
+		}
+	}
+
+	for id, want := range map[string]int{used.ID: http.StatusOK, idle.ID: http.StatusNotFound, last.ID: http.StatusOK} {
+		if code, _ := send(http.MethodGet, "/api/simplex/sessions/"+id); code != want {
+			t.Errorf("Sesión %s: se esperaba %d, got %d", id, want, code)
+		}
+	}
+}
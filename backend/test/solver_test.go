@@ -3,6 +3,7 @@ package test
 import (
 	"math"
 	"proyecto/simplex/logic"
+	"proyecto/simplex/models"
 	"testing"
 )
 
@@ -91,3 +92,44 @@ func TestSolveSimplex_ProblemaIlimitado(t *testing.T) {
 		t.Errorf("Resultado incorrecto, se esperaba unbounded, got: %v", result.Status)
 	}
 }
+
+// Test: cota inferior positiva en un modelo de MAX (se resuelve con x = x' + l)
+func TestSolveRequest_CotaInferiorMax(t *testing.T) {
+	cota := func(v float64) *float64 { return &v }
+	req := models.SimplexRequest{
+		Objective:       []float64{3, 5},
+		Constraints:     [][]float64{{1, 0}, {0, 2}, {3, 2}},
+		RHS:             []float64{4, 12, 18},
+		Type:            "max",
+		ConstraintTypes: []string{"le", "le", "le"},
+		Bounds:          []models.Bound{{Lower: cota(1)}, {}},
+	}
+
+	result, err := logic.SolveRequest(req)
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	if result.State != models.StatusOptimal || result.Optimal != 36 || result.Variables["x1"] != 2 || result.Variables["x2"] != 6 {
+		t.Fatalf("Se esperaba Z = 36 en (2, 6), got: %v %v %v", result.Status, result.Optimal, result.Variables)
+	}
+
+	// Con la cota activa: x1 queda en 3 y la sensibilidad se informa en x
+	req.Bounds[0] = models.Bound{Lower: cota(3), Upper: cota(5)}
+	result, err = logic.SolveRequest(req)
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	if result.Optimal != 31.5 || result.Variables["x1"] != 3 || result.Variables["x2"] != 4.5 {
+		t.Fatalf("Se esperaba Z = 31.5 en (3, 4.5), got: %v %v", result.Optimal, result.Variables)
+	}
+	s := result.Sensitivity
+	if s == nil || s.Variables[0].Value != 3 || s.Variables[0].ReducedCost != -4.5 {
+		t.Fatalf("Sensibilidad de x1 incorrecta: %+v", s)
+	}
+	if c := s.Constraints[2]; c.RHS != 18 || c.ShadowPrice != 2.5 || *c.Lower != 9 || *c.Upper != 21 {
+		t.Errorf("Sensibilidad de c3 incorrecta: %+v", c)
+	}
+	if c := s.Constraints[3]; c.Name != "ub_x1" || c.RHS != 5 || c.Slack != 2 {
+		t.Errorf("Sensibilidad de la cota superior incorrecta: %+v", c)
+	}
+}
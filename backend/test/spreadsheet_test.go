@@ -0,0 +1,104 @@
+package test
+
+import (
+	"errors"
+	"fmt"
+	"proyecto/simplex/formats"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// Test: CSV separado por ';' con coma decimal
+func TestParseSpreadsheet_CSV(t *testing.T) {
+	src := "max;x;y;type;rhs\n" +
+		"objetivo;3;5;;\n" +
+		"c1;1;;<=;4\n" +
+		"\n" +
+		"c2;;2;<=;12\n" +
+		"c3;3;2;le;18,5\n"
+
+	rows, err := formats.ReadCSV(strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	req, err := formats.ParseSpreadsheet(rows)
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+
+	if req.Type != "max" || !reflect.DeepEqual(req.VariableNames, []string{"x", "y"}) {
+		t.Errorf("Encabezado incorrecto, got: %v %v", req.Type, req.VariableNames)
+	}
+	if !reflect.DeepEqual(req.Constraints, [][]float64{{1, 0}, {0, 2}, {3, 2}}) {
+		t.Errorf("Restricciones incorrectas, got: %v", req.Constraints)
+	}
+	if !reflect.DeepEqual(req.RHS, []float64{4, 12, 18.5}) {
+		t.Errorf("RHS incorrecto, got: %v", req.RHS)
+	}
+}
+
+// Test: se informan todas las celdas inválidas con fila y columna
+func TestParseSpreadsheet_ErroresDeCelda(t *testing.T) {
+	rows := [][]string{
+		{"max", "x1", "x2", "type", "rhs"},
+		{"z", "3", "abc"},
+		{"c1", "1", "0", "<=", "4"},
+		{"c2", "0", "2", "menor", "doce"},
+	}
+
+	_, err := formats.ParseSpreadsheet(rows)
+	var sheetErrs formats.SheetErrors
+	if !errors.As(err, &sheetErrs) {
+		t.Fatalf("Se esperaba SheetErrors, got: %v", err)
+	}
+
+	got := make([]string, len(sheetErrs))
+	for i, e := range sheetErrs {
+		got[i] = fmt.Sprintf("%s%d", e.Column, e.Row)
+	}
+	want := []string{"C2", "D4", "E4"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Celdas con error incorrectas, got: %v, want: %v (%v)", got, want, err)
+	}
+}
+
+// Test: lectura de un XLSX con el mismo formato
+func TestParseSpreadsheet_XLSX(t *testing.T) {
+	f := excelize.NewFile()
+	defer f.Close()
+	data := [][]any{
+		{"min", "a", "b", "type", "rhs"},
+		{"costo", 60, 80},
+		{"r1", 6, 5, ">=", 50},
+		{"r2", 2, 5, ">=", 30},
+	}
+	for i, row := range data {
+		cell, _ := excelize.CoordinatesToCellName(1, i+1)
+		if err := f.SetSheetRow("Sheet1", cell, &row); err != nil {
+			t.Fatalf("Error al armar el XLSX: %v", err)
+		}
+	}
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		t.Fatalf("Error al armar el XLSX: %v", err)
+	}
+
+	rows, err := formats.ReadXLSX(buf, "")
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	req, err := formats.ParseSpreadsheet(rows)
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+
+	if req.Type != "min" || !reflect.DeepEqual(req.Objective, []float64{60, 80}) {
+		t.Errorf("Objetivo incorrecto, got: %v %v", req.Type, req.Objective)
+	}
+	if !reflect.DeepEqual(req.ConstraintTypes, []string{"ge", "ge"}) || !reflect.DeepEqual(req.ConstraintNames, []string{"r1", "r2"}) {
+		t.Errorf("Restricciones incorrectas, got: %v %v", req.ConstraintTypes, req.ConstraintNames)
+	}
+}
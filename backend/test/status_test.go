@@ -0,0 +1,87 @@
+package test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"proyecto/simplex/handlers"
+	"proyecto/simplex/logic"
+	"proyecto/simplex/models"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Test: el solver informa el estado tipado y las banderas de la solución
+func TestSolveSimplex_EstadoTipado(t *testing.T) {
+	cases := []struct {
+		name        string
+		c           []float64
+		A           [][]float64
+		b           []float64
+		state       models.Status
+		degenerate  bool
+		alternative bool
+	}{
+		{"caso básico", []float64{3, 5}, [][]float64{{1, 0}, {0, 2}, {3, 2}}, []float64{4, 12, 18}, models.StatusOptimal, false, false},
+		{"óptimos alternativos", []float64{1, 1}, [][]float64{{1, 1}}, []float64{4}, models.StatusOptimal, false, true},
+		{"degenerado", []float64{3, 5}, [][]float64{{1, 0}, {0, 2}, {3, 2}, {0, 1}}, []float64{4, 12, 18, 6}, models.StatusOptimal, true, false},
+		{"ilimitado", []float64{1, 1}, [][]float64{{-1, 1}}, []float64{1}, models.StatusUnbounded, false, false},
+		{"inviable", []float64{1, 1}, [][]float64{{1, 1}}, []float64{-1}, models.StatusInfeasible, false, false},
+	}
+
+	for _, tc := range cases {
+		types := make([]string, len(tc.b))
+		for i := range types {
+			types[i] = "le"
+		}
+		result := logic.SolveSimplexMaxWithTypes(tc.c, tc.A, tc.b, types)
+		if result.State != tc.state || result.Degenerate != tc.degenerate || result.AlternativeOptima != tc.alternative {
+			t.Errorf("%s: got estado %q, degenerada %v, alternativos %v", tc.name, result.State, result.Degenerate, result.AlternativeOptima)
+		}
+	}
+
+	result := logic.SolveSimplexMaxWithTypes([]float64{1}, [][]float64{{1}}, []float64{1}, []string{"eq"})
+	if result.State != models.StatusError || result.Err == nil || !strings.HasPrefix(result.Status, "error:") {
+		t.Errorf("Se esperaba estado error, got: %q (%q)", result.State, result.Status)
+	}
+}
+
+// postSimplexV2 envía el modelo a /api/v2/simplex y devuelve el código y la respuesta
+func postSimplexV2(t *testing.T, body string) (int, models.SimplexResponseV2) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.POST("/api/v2/simplex", handlers.SolveSimplexV2Handler)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/v2/simplex", bytes.NewBufferString(body)))
+
+	var resp models.SimplexResponseV2
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Respuesta inválida: %v (%s)", err, w.Body.String())
+	}
+	return w.Code, resp
+}
+
+// Test: la respuesta v2 expone el estado tipado, las banderas y el objeto de error
+func TestSolveSimplexV2Handler(t *testing.T) {
+	code, resp := postSimplexV2(t, `{"objective":[1,1],"constraints":[[1,1]],"rhs":[4],"type":"max","constraint_types":["le"]}`)
+	if code != http.StatusOK || resp.Version != "2" || resp.Status != models.StatusOptimal {
+		t.Fatalf("Respuesta incorrecta: %d %+v", code, resp)
+	}
+	if resp.Optimal == nil || *resp.Optimal != 4 || !resp.AlternativeOptima || resp.Error != nil {
+		t.Errorf("Solución incorrecta: %+v", resp)
+	}
+
+	code, resp = postSimplexV2(t, `{"objective":[1,1],"constraints":[[-1,1]],"rhs":[1],"type":"max","constraint_types":["le"]}`)
+	if code != http.StatusOK || resp.Status != models.StatusUnbounded || resp.Optimal != nil {
+		t.Errorf("Se esperaba unbounded sin valor óptimo: %d %+v", code, resp)
+	}
+
+	code, resp = postSimplexV2(t, `{"objective":[1],"constraints":[[1]],"rhs":[1],"type":"max","constraint_types":["eq"]}`)
+	if code != http.StatusUnprocessableEntity || resp.Status != models.StatusError || resp.Error == nil || resp.Error.Code != "equality_unsupported" {
+		t.Errorf("Se esperaba error 422: %d %+v", code, resp)
+	}
+}
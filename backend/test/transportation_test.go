@@ -0,0 +1,93 @@
+package test
+
+import (
+	"proyecto/simplex/logic"
+	"proyecto/simplex/models"
+	"testing"
+)
+
+// casoTransporte es el ejemplo clásico de 3 orígenes y 4 destinos (óptimo 435)
+func casoTransporte(method string) models.TransportationRequest {
+	return models.TransportationRequest{
+		Supply: []float64{15, 25, 10},
+		Demand: []float64{5, 15, 15, 15},
+		Costs:  [][]float64{{10, 2, 20, 11}, {12, 7, 9, 20}, {4, 14, 16, 18}},
+		Method: method,
+	}
+}
+
+// Test: los tres métodos iniciales llegan al mismo óptimo con MODI
+func TestSolveTransportation_MetodosIniciales(t *testing.T) {
+	tests := []struct {
+		method      string
+		initialCost float64
+	}{
+		{models.TransportNorthwest, 520},
+		{models.TransportLeastCost, 475},
+		{models.TransportVogel, 475},
+	}
+	for _, tc := range tests {
+		response, err := logic.SolveTransportation(casoTransporte(tc.method))
+		if err != nil {
+			t.Fatalf("%s: error inesperado: %v", tc.method, err)
+		}
+		if len(response.Initial) != 6 || response.Steps[0].Cost != tc.initialCost {
+			t.Errorf("%s: solución inicial incorrecta (costo %v, %d asignaciones)", tc.method, response.Steps[0].Cost, len(response.Initial))
+		}
+		if response.Status != models.StatusOptimal || response.TotalCost != 435 || response.Dummy != "" {
+			t.Errorf("%s: se esperaba el óptimo 435, got %v %v", tc.method, response.Status, response.TotalCost)
+		}
+		for k, step := range response.Steps[:len(response.Steps)-1] {
+			if step.Entering == nil || step.Leaving == nil || len(step.Cycle)%2 != 0 || len(step.Cycle) < 4 {
+				t.Errorf("%s: tabla %d sin decisión válida: %+v", tc.method, k, step)
+			}
+		}
+	}
+}
+
+// Test: oferta mayor que la demanda (destino ficticio) y solución degenerada
+func TestSolveTransportation_BalanceoYDegeneracion(t *testing.T) {
+	response, err := logic.SolveTransportation(models.TransportationRequest{
+		Supply: []float64{50, 60},
+		Demand: []float64{30, 40},
+		Costs:  [][]float64{{2, 3}, {4, 1}},
+	})
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	if response.Dummy != "destination" || len(response.Destinations) != 3 || response.Demand[2] != 40 || response.TotalCost != 100 {
+		t.Errorf("Balanceo incorrecto: %+v", response)
+	}
+
+	response, err = logic.SolveTransportation(models.TransportationRequest{
+		Supply: []float64{10, 10},
+		Demand: []float64{10, 10},
+		Costs:  [][]float64{{1, 2}, {3, 1}},
+	})
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	if !response.Degenerate || len(response.Steps[0].Basis) != 3 || response.TotalCost != 20 {
+		t.Errorf("Se esperaba una solución degenerada con 3 rutas básicas: %+v", response)
+	}
+}
+
+// Test: demanda mayor que la oferta (origen ficticio con costo 0): la demanda que
+// queda sin cubrir es la que recibe el origen ficticio
+func TestSolveTransportation_OrigenFicticio(t *testing.T) {
+	response, err := logic.SolveTransportation(models.TransportationRequest{
+		Supply: []float64{20, 30},
+		Demand: []float64{25, 35},
+		Costs:  [][]float64{{3, 5}, {4, 2}},
+	})
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	if response.Dummy != "source" || len(response.Supply) != 3 || response.Supply[2] != 10 {
+		t.Fatalf("Se esperaba un origen ficticio con oferta 10: %+v", response)
+	}
+	final := response.Steps[len(response.Steps)-1].Allocation
+	if response.Status != models.StatusOptimal || response.TotalCost != 120 || final[0][0] != 20 || final[1][1] != 30 || final[2][0]+final[2][1] != 10 {
+		t.Errorf("Se esperaba el óptimo 120 con O1->D1 y O2->D2, got %v: %v", response.TotalCost, final)
+	}
+}
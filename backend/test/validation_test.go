@@ -3,6 +3,7 @@ package test
 import (
 	"math"
 	"proyecto/simplex/logic"
+	"reflect"
 	"strings"
 	"testing"
 )
@@ -117,3 +118,55 @@ func TestSolveSimplexMin_Detallado_Pasos(t *testing.T) {
 		t.Errorf("Historial de tablas incorrecto. Se esperaban %d tablas, got: %d", expectedTableauxCount, len(result.TableauxHistory))
 	}
 }
+
+// Test: cada tabla registra la base, el pivote elegido y los cocientes del test de razón
+func TestSolveSimplexMax_MetadatosDePivote(t *testing.T) {
+	c := []float64{3, 5}
+	A := [][]float64{
+		{1, 0},
+		{0, 2},
+		{3, 2},
+	}
+	b := []float64{4, 12, 18}
+	types := []string{"le", "le", "le"}
+
+	result := logic.SolveSimplexMaxWithTypes(c, A, b, types)
+	if len(result.TableauxHistory) != 3 {
+		t.Fatalf("Se esperaban 3 tablas, got: %d", len(result.TableauxHistory))
+	}
+
+	first := result.TableauxHistory[0]
+	if !reflect.DeepEqual(first.Basis, []string{"Z", "s1", "s2", "s3"}) {
+		t.Errorf("Base inicial incorrecta, got: %v", first.Basis)
+	}
+	if first.Entering != "x2" || first.Leaving != "s2" {
+		t.Errorf("Pivote incorrecto: entra %q, sale %q", first.Entering, first.Leaving)
+	}
+	if first.PivotRow != 2 || first.PivotCol != 2 || first.PivotValue != 2 {
+		t.Errorf("Posición del pivote incorrecta: fila %d, columna %d, valor %v", first.PivotRow, first.PivotCol, first.PivotValue)
+	}
+
+	wantRatios := []any{nil, nil, 6.0, 9.0}
+	if len(first.Ratios) != len(wantRatios) {
+		t.Fatalf("Se esperaban %d cocientes, got: %d", len(wantRatios), len(first.Ratios))
+	}
+	for i, want := range wantRatios {
+		got := first.Ratios[i]
+		if (want == nil) != (got == nil) || (got != nil && *got != want.(float64)) {
+			t.Errorf("Cociente de la fila %d incorrecto, got: %v, want: %v", i, got, want)
+		}
+	}
+
+	second := result.TableauxHistory[1]
+	if second.Entering != "x1" || second.Leaving != "s3" {
+		t.Errorf("Segundo pivote incorrecto: entra %q, sale %q", second.Entering, second.Leaving)
+	}
+
+	last := result.TableauxHistory[2]
+	if last.Entering != "" || last.Ratios != nil {
+		t.Errorf("La tabla óptima no debería tener pivote, got: %+v", last)
+	}
+	if !reflect.DeepEqual(last.Basis, []string{"Z", "s1", "x2", "x1"}) {
+		t.Errorf("Base final incorrecta, got: %v", last.Basis)
+	}
+}
@@ -0,0 +1,173 @@
+package test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"proyecto/simplex/handlers"
+	"proyecto/simplex/logic"
+	"proyecto/simplex/models"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func verifyCasoBasico(solution, duals map[string]float64) models.VerifyRequest {
+	return models.VerifyRequest{
+		SimplexRequest: models.SimplexRequest{
+			Objective:       []float64{3, 5},
+			Constraints:     [][]float64{{1, 0}, {0, 2}, {3, 2}},
+			RHS:             []float64{4, 12, 18},
+			Type:            "max",
+			ConstraintTypes: []string{"le", "le", "le"},
+		},
+		Solution: solution,
+		Duals:    duals,
+	}
+}
+
+// Test: la solución óptima se certifica con los duales óptimos calculados
+func TestVerifySolution_Optima(t *testing.T) {
+	response, err := logic.VerifySolution(verifyCasoBasico(map[string]float64{"x1": 2, "x2": 6}, nil))
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	if !response.Feasible || !response.Optimal || response.Objective != 36 || *response.OptimalValue != 36 {
+		t.Errorf("Verificación incorrecta: %+v", response)
+	}
+	if len(response.Residuals) != 5 || response.Residuals[2].Name != "c3" || response.Residuals[2].LHS != 18 || response.Residuals[2].Residual != 0 {
+		t.Errorf("Residuos incorrectos: %+v", response.Residuals)
+	}
+	if response.DualsSource != "computed" || !reflect.DeepEqual(response.Duals, map[string]float64{"c1": 0, "c2": 1.5, "c3": 1}) {
+		t.Errorf("Duales incorrectos: %q %v", response.DualsSource, response.Duals)
+	}
+	if !response.DualFeasible || *response.DualityGap != 0 || response.ReducedCosts["x1"] != 0 || response.ReducedCosts["x2"] != 0 {
+		t.Errorf("Certificado incorrecto: %+v", response)
+	}
+	if response.Message != "La solución es factible y óptima (Z = 36)." {
+		t.Errorf("Mensaje inesperado: %q", response.Message)
+	}
+}
+
+// Test: soluciones infactibles, factibles no óptimas y duales que no certifican
+func TestVerifySolution_NoOptima(t *testing.T) {
+	response, err := logic.VerifySolution(verifyCasoBasico(map[string]float64{"x1": 5, "x2": 6}, nil))
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	if response.Feasible || response.Optimal || response.Residuals[0].Satisfied || response.Residuals[0].Residual != 1 || response.Residuals[2].Satisfied {
+		t.Errorf("Se esperaba infactible en c1 y c3: %+v", response.Residuals)
+	}
+	if response.Message != "La solución no es factible: 2 restricciones no se cumplen." {
+		t.Errorf("Mensaje inesperado: %q", response.Message)
+	}
+
+	response, _ = logic.VerifySolution(verifyCasoBasico(map[string]float64{"x1": 2}, nil))
+	if !response.Feasible || response.Optimal || response.Objective != 6 || *response.DualityGap != -30 {
+		t.Errorf("Se esperaba factible no óptima: %+v", response)
+	}
+
+	response, _ = logic.VerifySolution(verifyCasoBasico(map[string]float64{"x1": 2, "x2": 6}, map[string]float64{"c1": 1}))
+	if !response.Optimal || response.DualsSource != "claimed" || response.DualFeasible {
+		t.Errorf("Los duales indicados no son factibles: %+v", response)
+	}
+	want := "La solución es factible y óptima (Z = 36). Los duales indicados no certifican la optimalidad: no son factibles o la brecha de dualidad no es 0."
+	if response.Message != want {
+		t.Errorf("Mensaje inesperado: %q", response.Message)
+	}
+}
+
+// Test: una solución calculada con dos decimales se acepta con la tolerancia por
+// defecto (0.01) y se rechaza con una tolerancia menor
+func TestVerifySolution_Tolerancia(t *testing.T) {
+	req := verifyCasoBasico(map[string]float64{"x1": 2.001, "x2": 5.998}, nil)
+	response, err := logic.VerifySolution(req)
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	if !response.Feasible || !response.Optimal || !response.DualFeasible {
+		t.Errorf("Se esperaba factible y óptima con tolerancia 0.01: %+v", response)
+	}
+
+	req.Solution = map[string]float64{"x1": 2.004, "x2": 6}
+	response, _ = logic.VerifySolution(req)
+	if response.Feasible || response.Residuals[2].Satisfied {
+		t.Errorf("c3 se excede en 0.012 y no debería cumplirse: %+v", response.Residuals)
+	}
+
+	req.Solution = map[string]float64{"x1": 2.001, "x2": 5.998}
+	req.Tolerance = 0.001
+	response, _ = logic.VerifySolution(req)
+	if !response.Feasible || response.Optimal {
+		t.Errorf("Z = 35.993 no es óptima con tolerancia 0.001: %+v", response)
+	}
+}
+
+// Test: una igualdad redundante (múltiplo de otra) no impide calcular el óptimo de
+// referencia, y una contradictoria hace infactible al modelo
+func TestVerifySolution_IgualdadesRedundantes(t *testing.T) {
+	req := models.VerifyRequest{
+		SimplexRequest: models.SimplexRequest{
+			Objective:       []float64{3, 5},
+			Constraints:     [][]float64{{1, 1}, {2, 2}, {0, 1}},
+			RHS:             []float64{4, 8, 3},
+			Type:            "max",
+			ConstraintTypes: []string{"eq", "eq", "le"},
+		},
+		Solution: map[string]float64{"x1": 1, "x2": 3},
+	}
+	response, err := logic.VerifySolution(req)
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	if !response.Feasible || !response.Optimal || response.OptimalValue == nil || *response.OptimalValue != 18 {
+		t.Errorf("Se esperaba factible y óptima con Z = 18: %+v", response)
+	}
+
+	req.RHS[1] = 9
+	response, _ = logic.VerifySolution(req)
+	if response.Feasible || response.OptimalValue != nil || response.Message == "" {
+		t.Errorf("Con 2x1 + 2x2 = 9 el modelo es infactible: %+v", response)
+	}
+}
+
+// Test: variables y restricciones desconocidas se informan con su ruta
+func TestVerifySolution_NombresDesconocidos(t *testing.T) {
+	_, err := logic.VerifySolution(verifyCasoBasico(map[string]float64{"x3": 1}, map[string]float64{"c9": 1}))
+
+	var errs logic.ValidationErrors
+	if !errors.As(err, &errs) || len(errs) != 2 || errs[0].Field != "solution.x3" || errs[1].Field != "duals.c9" {
+		t.Fatalf("Se esperaban errores en solution.x3 y duals.c9, got: %v", err)
+	}
+}
+
+// Test: endpoint /api/simplex/verify con igualdades y nombres de variables
+func TestVerifyHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.POST("/api/simplex/verify", handlers.VerifyHandler)
+
+	body := `{"objective":[2,3],"constraints":[[1,1],[1,-1]],"rhs":[4,1],"type":"min","constraint_types":["ge","eq"],
+		"variable_names":["a","b"],"solution":{"a":2.5,"b":1.5},"duals":{"c1":2.5,"c2":-0.5},"language":"en"}`
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/simplex/verify", bytes.NewBufferString(body)))
+	if w.Code != http.StatusOK {
+		t.Fatalf("Se esperaba 200, got %d: %s", w.Code, w.Body.String())
+	}
+	var response models.VerifyResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+		t.Fatalf("Respuesta inválida: %v", err)
+	}
+	if !response.Optimal || !response.DualFeasible || *response.DualityGap != 0 || response.Message != "The solution is feasible and optimal (Z = 9.5)." {
+		t.Errorf("Respuesta incorrecta: %+v", response)
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/simplex/verify", bytes.NewBufferString(`{"objective":[1],"constraints":[[1]],"rhs":[1],"type":"max","constraint_types":["le"],"solution":{"y":1}}`)))
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Se esperaba 400, got %d: %s", w.Code, w.Body.String())
+	}
+}
@@ -0,0 +1,103 @@
+package test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"proyecto/simplex/handlers"
+	"proyecto/simplex/logic"
+	"proyecto/simplex/models"
+	"slices"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Test: los 5 vértices del caso básico y el recorrido del Simplex por ellos
+func TestEnumerateVertices_CasoBasico(t *testing.T) {
+	req, _ := casoBasico(t)
+	response, err := logic.EnumerateVertices(req)
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	if len(response.Vertices) != 5 || response.Bases != 10 || response.SingularBases+response.InfeasibleBases != 5 {
+		t.Fatalf("Enumeración incorrecta: %+v", response)
+	}
+
+	vertexAt := func(x1, x2 float64) int {
+		return slices.IndexFunc(response.Vertices, func(v models.Vertex) bool {
+			return v.Variables["x1"] == x1 && v.Variables["x2"] == x2
+		})
+	}
+	optimal := vertexAt(2, 6)
+	if *response.BestObjective != 36 || !slices.Equal(response.BestVertices, []int{optimal}) {
+		t.Errorf("Se esperaba el mejor vértice (2, 6) con Z = 36, got %v %v", response.BestObjective, response.BestVertices)
+	}
+	if v := response.Vertices[optimal]; !slices.Equal(v.Active, []string{"c2", "c3"}) || v.Slacks["c1"] != 2 || v.Degenerate {
+		t.Errorf("Vértice óptimo incorrecto: %+v", v)
+	}
+
+	want := []int{vertexAt(0, 0), vertexAt(0, 6), optimal}
+	if len(response.Path) != len(want) {
+		t.Fatalf("Se esperaban %d tablas en el recorrido, got %+v", len(want), response.Path)
+	}
+	for k, step := range response.Path {
+		if step.Vertex == nil || *step.Vertex != want[k] {
+			t.Errorf("Tabla %d: se esperaba el vértice %d, got %+v", k, want[k], step)
+		}
+	}
+}
+
+// Test: un vértice degenerado aparece una vez con todas las bases que lo generan
+func TestEnumerateVertices_Degenerado(t *testing.T) {
+	response, err := logic.EnumerateVertices(models.SimplexRequest{
+		Objective:       []float64{1, 1},
+		Constraints:     [][]float64{{1, 0}, {0, 1}, {1, 1}},
+		RHS:             []float64{1, 1, 2},
+		Type:            "max",
+		ConstraintTypes: []string{"le", "le", "le"},
+	})
+	if err != nil {
+		t.Fatalf("Error inesperado: %v", err)
+	}
+	k := response.BestVertices[0]
+	if v := response.Vertices[k]; len(response.BestVertices) != 1 || !v.Degenerate || len(v.Bases) != 3 || len(v.Active) != 3 {
+		t.Errorf("Se esperaba el vértice (1, 1) degenerado con 3 bases: %+v", v)
+	}
+}
+
+// Test: endpoint de vértices y límite de combinaciones
+func TestVerticesHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.POST("/api/simplex/vertices", handlers.VerticesHandler)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/simplex/vertices", bytes.NewBufferString(
+		`{"objective":[3,5],"constraints":[[1,0],[0,2],[3,2]],"rhs":[4,12,18],"type":"max","constraint_types":["le","le","le"],"language":"en"}`)))
+	var response models.VertexEnumeration
+	json.Unmarshal(w.Body.Bytes(), &response)
+	if w.Code != http.StatusOK || len(response.Vertices) != 5 || response.Message != "5 vertices found trying 10 bases (2 singular, 3 infeasible)." {
+		t.Errorf("Se esperaban 5 vértices, got %d: %s", w.Code, w.Body.String())
+	}
+
+	// 15 variables y 15 restricciones: C(30, 15) bases
+	req := models.SimplexRequest{Type: "max"}
+	for i := range 15 {
+		req.Objective = append(req.Objective, 1)
+		row := make([]float64, 15)
+		row[i] = 1
+		req.Constraints = append(req.Constraints, row)
+		req.RHS = append(req.RHS, 1)
+		req.ConstraintTypes = append(req.ConstraintTypes, "le")
+	}
+	body, _ := json.Marshal(req)
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/simplex/vertices", bytes.NewBuffer(body)))
+	var errResponse models.ErrorResponse
+	json.Unmarshal(w.Body.Bytes(), &errResponse)
+	if w.Code != http.StatusUnprocessableEntity || errResponse.Error.Code != "vertices_too_many" {
+		t.Errorf("Se esperaba 422 vertices_too_many, got %d: %s", w.Code, w.Body.String())
+	}
+}
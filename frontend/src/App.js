@@ -88,7 +88,9 @@ import SimplexResult from "./components/SimplexResult";
       });
       if (!res.ok) {
         const err = await res.json().catch(() => ({}));
-        throw new Error(err.error || res.statusText || "Error en la solicitud");
+        const apiError = err.error || {};
+        const details = (apiError.details?.errors || []).map((e) => `${e.field}: ${e.message}`);
+        throw new Error([apiError.message, ...details].filter(Boolean).join("\n") || res.statusText || "Error en la solicitud");
       }
       const data = await res.json();
       setResult({ ...data.result, type: type });